@@ -0,0 +1,22 @@
+// SPDX-License-Identifier: Apache-2.0
+pragma solidity ^0.8.20;
+
+/**
+ * @dev The subset of the ICS-20 app interface of ibc-solidity that the relayer uses to send transfers.
+ */
+interface IICS20Transfer {
+    /**
+     * @dev sendTransfer sends a transfer packet to the destination chain.
+     * If `denom` is the address of an ERC-20 token, the amount is escrowed with `transferFrom`,
+     * so the sender must approve the app to spend it beforehand.
+     * @return sequence the sequence of the packet
+     */
+    function sendTransfer(
+        string calldata denom,
+        uint256 amount,
+        string calldata receiver,
+        string calldata sourcePort,
+        string calldata sourceChannel,
+        uint64 timeoutHeight
+    ) external returns (uint64);
+}
@@ -3,6 +3,7 @@ package client
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"math/big"
 	"net/http"
 	"time"
@@ -14,6 +15,7 @@ import (
 	gethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/ethclient/gethclient"
 	"github.com/ethereum/go-ethereum/rpc"
 	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 )
@@ -199,6 +201,32 @@ func (c *CallFrame) UnmarshalJSON(input []byte) error {
 }
 
 func (cl *ETHClient) EstimateGasFromTx(ctx context.Context, tx *gethtypes.Transaction, from common.Address, gasCap uint64) (uint64, error) {
+	callMsg, err := callMsgFromTx(tx, from, gasCap)
+	if err != nil {
+		return 0, err
+	}
+	return cl.EstimateGas(ctx, callMsg)
+}
+
+// CreateAccessListFromTx returns the access list generated by `eth_createAccessList` for the tx
+// and the gas used by the tx with the list
+func (cl *ETHClient) CreateAccessListFromTx(ctx context.Context, tx *gethtypes.Transaction, from common.Address, gasCap uint64) (gethtypes.AccessList, uint64, error) {
+	callMsg, err := callMsgFromTx(tx, from, gasCap)
+	if err != nil {
+		return nil, 0, err
+	}
+	accessList, gasUsed, vmErr, err := gethclient.New(cl.Raw()).CreateAccessList(ctx, callMsg)
+	if err != nil {
+		return nil, 0, err
+	} else if vmErr != "" {
+		return nil, 0, fmt.Errorf("execution failed: %s", vmErr)
+	} else if accessList == nil {
+		return nil, gasUsed, nil
+	}
+	return *accessList, gasUsed, nil
+}
+
+func callMsgFromTx(tx *gethtypes.Transaction, from common.Address, gasCap uint64) (ethereum.CallMsg, error) {
 	callMsg := ethereum.CallMsg{
 		From:  from,
 		To:    tx.To(),
@@ -224,8 +252,7 @@ func (cl *ETHClient) EstimateGasFromTx(ctx context.Context, tx *gethtypes.Transa
 	case gethtypes.LegacyTxType:
 		callMsg.GasPrice = tx.GasPrice()
 	default:
-		panic("unsupported tx type")
+		return ethereum.CallMsg{}, fmt.Errorf("%w: %d", ErrUnsupportedTxType, tx.Type())
 	}
-
-	return cl.EstimateGas(ctx, callMsg)
+	return callMsg, nil
 }
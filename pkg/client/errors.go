@@ -0,0 +1,8 @@
+package client
+
+import "errors"
+
+var (
+	// ErrUnsupportedTxType is returned when the type of a given transaction is not supported
+	ErrUnsupportedTxType = errors.New("unsupported tx type")
+)
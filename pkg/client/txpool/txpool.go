@@ -92,11 +92,17 @@ func GetMinimumRequiredFee(ctx context.Context, client *ethclient.Client, addres
 		return nil, common.Big0, common.Big0, nil
 	}
 
-	gasFeeCap := new(big.Int).Set(targetTx.GasFeeCap.ToInt())
-	gasTipCap := new(big.Int).Set(targetTx.GasTipCap.ToInt())
+	gasFeeCap, gasTipCap := MinimumRequiredFee(targetTx, priceBump)
+	return targetTx, gasFeeCap, gasTipCap, nil
+}
+
+// MinimumRequiredFee returns the minimum gas fee cap and gas tip cap required to replace the pending tx
+func MinimumRequiredFee(tx *RPCTransaction, priceBump uint64) (*big.Int, *big.Int) {
+	gasFeeCap := new(big.Int).Set(tx.GasFeeCap.ToInt())
+	gasTipCap := new(big.Int).Set(tx.GasTipCap.ToInt())
 
 	inclByPercent(gasFeeCap, priceBump)
 	inclByPercent(gasTipCap, priceBump)
 
-	return targetTx, gasFeeCap, gasTipCap, nil
+	return gasFeeCap, gasTipCap
 }
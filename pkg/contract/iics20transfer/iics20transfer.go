@@ -0,0 +1,202 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package iics20transfer
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// Iics20transferMetaData contains all meta data concerning the Iics20transfer contract.
+var Iics20transferMetaData = &bind.MetaData{
+	ABI: "[{\"type\":\"function\",\"name\":\"sendTransfer\",\"inputs\":[{\"name\":\"denom\",\"type\":\"string\",\"internalType\":\"string\"},{\"name\":\"amount\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"receiver\",\"type\":\"string\",\"internalType\":\"string\"},{\"name\":\"sourcePort\",\"type\":\"string\",\"internalType\":\"string\"},{\"name\":\"sourceChannel\",\"type\":\"string\",\"internalType\":\"string\"},{\"name\":\"timeoutHeight\",\"type\":\"uint64\",\"internalType\":\"uint64\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint64\",\"internalType\":\"uint64\"}],\"stateMutability\":\"nonpayable\"}]",
+}
+
+// Iics20transferABI is the input ABI used to generate the binding from.
+// Deprecated: Use Iics20transferMetaData.ABI instead.
+var Iics20transferABI = Iics20transferMetaData.ABI
+
+// Iics20transfer is an auto generated Go binding around an Ethereum contract.
+type Iics20transfer struct {
+	Iics20transferCaller     // Read-only binding to the contract
+	Iics20transferTransactor // Write-only binding to the contract
+	Iics20transferFilterer   // Log filterer for contract events
+}
+
+// Iics20transferCaller is an auto generated read-only Go binding around an Ethereum contract.
+type Iics20transferCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// Iics20transferTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type Iics20transferTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// Iics20transferFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type Iics20transferFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// Iics20transferSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type Iics20transferSession struct {
+	Contract     *Iics20transfer   // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// Iics20transferCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type Iics20transferCallerSession struct {
+	Contract *Iics20transferCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts         // Call options to use throughout this session
+}
+
+// Iics20transferTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type Iics20transferTransactorSession struct {
+	Contract     *Iics20transferTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts         // Transaction auth options to use throughout this session
+}
+
+// Iics20transferRaw is an auto generated low-level Go binding around an Ethereum contract.
+type Iics20transferRaw struct {
+	Contract *Iics20transfer // Generic contract binding to access the raw methods on
+}
+
+// Iics20transferCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type Iics20transferCallerRaw struct {
+	Contract *Iics20transferCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// Iics20transferTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type Iics20transferTransactorRaw struct {
+	Contract *Iics20transferTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewIics20transfer creates a new instance of Iics20transfer, bound to a specific deployed contract.
+func NewIics20transfer(address common.Address, backend bind.ContractBackend) (*Iics20transfer, error) {
+	contract, err := bindIics20transfer(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &Iics20transfer{Iics20transferCaller: Iics20transferCaller{contract: contract}, Iics20transferTransactor: Iics20transferTransactor{contract: contract}, Iics20transferFilterer: Iics20transferFilterer{contract: contract}}, nil
+}
+
+// NewIics20transferCaller creates a new read-only instance of Iics20transfer, bound to a specific deployed contract.
+func NewIics20transferCaller(address common.Address, caller bind.ContractCaller) (*Iics20transferCaller, error) {
+	contract, err := bindIics20transfer(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &Iics20transferCaller{contract: contract}, nil
+}
+
+// NewIics20transferTransactor creates a new write-only instance of Iics20transfer, bound to a specific deployed contract.
+func NewIics20transferTransactor(address common.Address, transactor bind.ContractTransactor) (*Iics20transferTransactor, error) {
+	contract, err := bindIics20transfer(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &Iics20transferTransactor{contract: contract}, nil
+}
+
+// NewIics20transferFilterer creates a new log filterer instance of Iics20transfer, bound to a specific deployed contract.
+func NewIics20transferFilterer(address common.Address, filterer bind.ContractFilterer) (*Iics20transferFilterer, error) {
+	contract, err := bindIics20transfer(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &Iics20transferFilterer{contract: contract}, nil
+}
+
+// bindIics20transfer binds a generic wrapper to an already deployed contract.
+func bindIics20transfer(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := Iics20transferMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_Iics20transfer *Iics20transferRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Iics20transfer.Contract.Iics20transferCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_Iics20transfer *Iics20transferRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Iics20transfer.Contract.Iics20transferTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_Iics20transfer *Iics20transferRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Iics20transfer.Contract.Iics20transferTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_Iics20transfer *Iics20transferCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Iics20transfer.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_Iics20transfer *Iics20transferTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Iics20transfer.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_Iics20transfer *Iics20transferTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Iics20transfer.Contract.contract.Transact(opts, method, params...)
+}
+
+// SendTransfer is a paid mutator transaction binding the contract method 0xe0c0705d.
+//
+// Solidity: function sendTransfer(string denom, uint256 amount, string receiver, string sourcePort, string sourceChannel, uint64 timeoutHeight) returns(uint64)
+func (_Iics20transfer *Iics20transferTransactor) SendTransfer(opts *bind.TransactOpts, denom string, amount *big.Int, receiver string, sourcePort string, sourceChannel string, timeoutHeight uint64) (*types.Transaction, error) {
+	return _Iics20transfer.contract.Transact(opts, "sendTransfer", denom, amount, receiver, sourcePort, sourceChannel, timeoutHeight)
+}
+
+// SendTransfer is a paid mutator transaction binding the contract method 0xe0c0705d.
+//
+// Solidity: function sendTransfer(string denom, uint256 amount, string receiver, string sourcePort, string sourceChannel, uint64 timeoutHeight) returns(uint64)
+func (_Iics20transfer *Iics20transferSession) SendTransfer(denom string, amount *big.Int, receiver string, sourcePort string, sourceChannel string, timeoutHeight uint64) (*types.Transaction, error) {
+	return _Iics20transfer.Contract.SendTransfer(&_Iics20transfer.TransactOpts, denom, amount, receiver, sourcePort, sourceChannel, timeoutHeight)
+}
+
+// SendTransfer is a paid mutator transaction binding the contract method 0xe0c0705d.
+//
+// Solidity: function sendTransfer(string denom, uint256 amount, string receiver, string sourcePort, string sourceChannel, uint64 timeoutHeight) returns(uint64)
+func (_Iics20transfer *Iics20transferTransactorSession) SendTransfer(denom string, amount *big.Int, receiver string, sourcePort string, sourceChannel string, timeoutHeight uint64) (*types.Transaction, error) {
+	return _Iics20transfer.Contract.SendTransfer(&_Iics20transfer.TransactOpts, denom, amount, receiver, sourcePort, sourceChannel, timeoutHeight)
+}
@@ -0,0 +1,120 @@
+package ethereum
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/hyperledger-labs/yui-relayer/log"
+)
+
+// useAccessList returns true if an access list is created for the tx
+func (c ChainConfig) useAccessList(tx *gethtypes.Transaction) bool {
+	switch tx.Type() {
+	case gethtypes.LegacyTxType, gethtypes.AccessListTxType:
+		return c.TxType == TxTypeAccessList
+	case gethtypes.DynamicFeeTxType:
+		return c.CreateAccessList
+	default:
+		return false
+	}
+}
+
+// txWithAccessList returns a copy of the tx that carries the access list.
+// Legacy txs are converted into EIP-2930 access list txs because they cannot carry access lists.
+func txWithAccessList(tx *gethtypes.Transaction, accessList gethtypes.AccessList, chainID *big.Int) (*gethtypes.Transaction, error) {
+	switch tx.Type() {
+	case gethtypes.LegacyTxType, gethtypes.AccessListTxType:
+		return gethtypes.NewTx(&gethtypes.AccessListTx{
+			ChainID:    chainID,
+			Nonce:      tx.Nonce(),
+			GasPrice:   tx.GasPrice(),
+			Gas:        tx.Gas(),
+			To:         tx.To(),
+			Value:      tx.Value(),
+			Data:       tx.Data(),
+			AccessList: accessList,
+		}), nil
+	case gethtypes.DynamicFeeTxType:
+		return gethtypes.NewTx(&gethtypes.DynamicFeeTx{
+			ChainID:    chainID,
+			Nonce:      tx.Nonce(),
+			GasTipCap:  tx.GasTipCap(),
+			GasFeeCap:  tx.GasFeeCap(),
+			Gas:        tx.Gas(),
+			To:         tx.To(),
+			Value:      tx.Value(),
+			Data:       tx.Data(),
+			AccessList: accessList,
+		}), nil
+	default:
+		return nil, fmt.Errorf("%w: %d", ErrUnsupportedTxType, tx.Type())
+	}
+}
+
+// withAccessList returns the opts that make txs carry the access list before they are signed.
+// bind.TransactOpts.AccessList is not used because bind.BoundContract doesn't create access list txs.
+//
+// CONTRACT: the signer of `opts` must be set before calling this function.
+func withAccessList(opts *bind.TransactOpts, accessList gethtypes.AccessList, chainID *big.Int) *bind.TransactOpts {
+	if accessList == nil {
+		return opts
+	}
+	cloned := *opts
+	cloned.Signer = func(address common.Address, tx *gethtypes.Transaction) (*gethtypes.Transaction, error) {
+		tx, err := txWithAccessList(tx, accessList, chainID)
+		if err != nil {
+			return nil, err
+		}
+		return opts.Signer(address, tx)
+	}
+	return &cloned
+}
+
+// createAccessList creates the access list of the tx built for gas estimation, and returns it with
+// the gas limit estimated with it if the list reduces the gas limit from `gasLimit`.
+// Otherwise, a nil list is returned with `gasLimit`. Failures to create the list are not fatal
+// because not all nodes support eth_createAccessList.
+func createAccessList(
+	ctx context.Context,
+	c *Chain,
+	tx *gethtypes.Transaction,
+	gasLimit uint64,
+	logger *log.RelayLogger,
+) (gethtypes.AccessList, uint64) {
+	if !c.config.useAccessList(tx) {
+		return nil, gasLimit
+	}
+	accessList, _, err := c.client.CreateAccessListFromTx(ctx, tx, c.signerFor(ctx).Address(), c.Config().EstimateGasCap)
+	if err != nil {
+		logger.WarnContext(ctx, "failed to create access list", "error", err)
+		return nil, gasLimit
+	} else if len(accessList) == 0 {
+		return nil, gasLimit
+	}
+
+	txWithList, err := txWithAccessList(tx, accessList, c.chainID)
+	if err != nil {
+		logger.WarnContext(ctx, "failed to attach access list", "error", err)
+		return nil, gasLimit
+	}
+	gasLimitWithList, err := estimateGas(ctx, c, txWithList, true, logger)
+	if err != nil {
+		logger.WarnContext(ctx, "failed to estimate gas with access list", "error", err)
+		return nil, gasLimit
+	}
+	logger = &log.RelayLogger{Logger: logger.With(
+		logAttrEstimatedGas, gasLimit,
+		logAttrEstimatedGasWithAccessList, gasLimitWithList,
+		logAttrAccessListStorageKeys, accessList.StorageKeys(),
+	)}
+	if gasLimitWithList >= gasLimit {
+		logger.DebugContext(ctx, "access list is not attached because it doesn't reduce gas")
+		return nil, gasLimit
+	}
+	logger.InfoContext(ctx, "attach access list to tx")
+	return accessList, gasLimitWithList
+}
@@ -0,0 +1,114 @@
+package ethereum
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	chantypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/hyperledger-labs/yui-relayer/log"
+	"github.com/stretchr/testify/require"
+)
+
+var testAccessList = gethtypes.AccessList{{
+	Address:     common.HexToAddress("0x01"),
+	StorageKeys: []common.Hash{common.HexToHash("0x01"), common.HexToHash("0x02")},
+}}
+
+// accessListTestEthService creates testAccessList and estimates less gas for txs with access lists if `reduce` is true
+type accessListTestEthService struct {
+	testEthService
+	reduce bool
+}
+
+func (s *accessListTestEthService) EstimateGas(args map[string]interface{}) (hexutil.Uint64, error) {
+	if accessList, ok := args["accessList"].([]interface{}); ok && len(accessList) > 0 && s.reduce {
+		return 80_000, nil
+	}
+	return 100_000, nil
+}
+
+func (s *accessListTestEthService) CreateAccessList(args map[string]interface{}) (map[string]interface{}, error) {
+	return map[string]interface{}{"accessList": testAccessList, "gasUsed": hexutil.Uint64(75_000)}, nil
+}
+
+func TestTxWithAccessList(t *testing.T) {
+	chainID := big.NewInt(1)
+	to := common.HexToAddress("0x02")
+
+	legacyTx := gethtypes.NewTx(&gethtypes.LegacyTx{Nonce: 1, GasPrice: big.NewInt(10), Gas: 21000, To: &to, Value: big.NewInt(3), Data: []byte{1}})
+	tx, err := txWithAccessList(legacyTx, testAccessList, chainID)
+	require.NoError(t, err)
+	require.Equal(t, uint8(gethtypes.AccessListTxType), tx.Type())
+	require.Equal(t, chainID, tx.ChainId())
+	require.Equal(t, legacyTx.GasPrice(), tx.GasPrice())
+	require.Equal(t, legacyTx.Data(), tx.Data())
+	require.Equal(t, testAccessList, tx.AccessList())
+
+	dynamicTx := gethtypes.NewTx(&gethtypes.DynamicFeeTx{ChainID: chainID, Nonce: 1, GasTipCap: big.NewInt(1), GasFeeCap: big.NewInt(10), Gas: 21000, To: &to})
+	tx, err = txWithAccessList(dynamicTx, testAccessList, chainID)
+	require.NoError(t, err)
+	require.Equal(t, uint8(gethtypes.DynamicFeeTxType), tx.Type())
+	require.Equal(t, dynamicTx.GasTipCap(), tx.GasTipCap())
+	require.Equal(t, dynamicTx.GasFeeCap(), tx.GasFeeCap())
+	require.Equal(t, testAccessList, tx.AccessList())
+
+	// only access list txs and dynamic fee txs with create_access_list get access lists
+	for _, c := range []struct {
+		config ChainConfig
+		tx     *gethtypes.Transaction
+		use    bool
+	}{
+		{ChainConfig{TxType: TxTypeLegacy}, legacyTx, false},
+		{ChainConfig{TxType: TxTypeLegacy, CreateAccessList: true}, legacyTx, false},
+		{ChainConfig{TxType: TxTypeAccessList}, legacyTx, true},
+		{ChainConfig{TxType: TxTypeDynamic}, dynamicTx, false},
+		{ChainConfig{TxType: TxTypeDynamic, CreateAccessList: true}, dynamicTx, true},
+		{ChainConfig{TxType: TxTypeAuto, CreateAccessList: true}, dynamicTx, true},
+	} {
+		require.Equal(t, c.use, c.config.useAccessList(c.tx), c.config)
+	}
+}
+
+func TestBuildTxWithAccessList(t *testing.T) {
+	if err := log.InitLogger("DEBUG", "text", "null", false); err != nil {
+		t.Fatal(err)
+	}
+	service := &accessListTestEthService{reduce: true}
+	c := newTestChain(t)
+	c.config.TxType = TxTypeAccessList
+	withTestEthService(t, c, service)
+
+	msgs := []sdk.Msg{
+		&chantypes.MsgRecvPacket{Packet: chantypes.Packet{Sequence: 1}},
+		&chantypes.MsgRecvPacket{Packet: chantypes.Packet{Sequence: 2}},
+	}
+	buildTx := func(msgs []sdk.Msg) *gethtypes.Transaction {
+		iter := NewCallIter(msgs, false)
+		built, err := iter.BuildTx(context.Background(), c)
+		require.NoError(t, err)
+		require.Equal(t, len(msgs), built.count)
+		return built.tx
+	}
+
+	// both single and multicall txs are sent as signed access list txs
+	for _, msgs := range [][]sdk.Msg{msgs[:1], msgs} {
+		tx := buildTx(msgs)
+		require.Equal(t, uint8(gethtypes.AccessListTxType), tx.Type())
+		require.Equal(t, testAccessList, tx.AccessList())
+		require.Equal(t, uint64(80_000), tx.Gas())
+		sender, err := gethtypes.Sender(c.ethereumSigner.gethSigner, tx)
+		require.NoError(t, err)
+		require.Equal(t, c.ethereumSigner.Address(), sender)
+	}
+
+	// the list is not attached if it doesn't reduce gas
+	service.reduce = false
+	tx := buildTx(msgs[:1])
+	require.Equal(t, uint8(gethtypes.LegacyTxType), tx.Type())
+	require.Equal(t, uint64(100_000), tx.Gas())
+}
@@ -0,0 +1,290 @@
+package ethereum
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
+	gethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/gofrs/flock"
+	"github.com/hyperledger-labs/yui-relayer/core"
+)
+
+const auditLogFileName = "audit.jsonl"
+
+// auditEntry is a record of a tx signed by a relayer account.
+// Each entry contains the hash of the previous one so that modification or removal of past entries is detected.
+type auditEntry struct {
+	Time      time.Time       `json:"time"`
+	Signer    common.Address  `json:"signer"`
+	TxHash    common.Hash     `json:"tx_hash"`
+	Nonce     uint64          `json:"nonce"`
+	To        *common.Address `json:"to"`
+	Selector  hexutil.Bytes   `json:"selector"`
+	GasLimit  uint64          `json:"gas_limit"`
+	GasFeeCap *hexutil.Big    `json:"gas_fee_cap"`
+	GasTipCap *hexutil.Big    `json:"gas_tip_cap"`
+	MsgTypes  []string        `json:"msg_types,omitempty"`
+	Path      *core.PathEnd   `json:"path,omitempty"`
+	PrevHash  common.Hash     `json:"prev_hash"`
+	Hash      common.Hash     `json:"hash"`
+}
+
+func newAuditEntry(signer common.Address, tx *gethtypes.Transaction, opts SignOptions) auditEntry {
+	return auditEntry{
+		Time:      time.Now().UTC(),
+		Signer:    signer,
+		TxHash:    tx.Hash(),
+		Nonce:     tx.Nonce(),
+		To:        tx.To(),
+		Selector:  tx.Data()[:min(4, len(tx.Data()))],
+		GasLimit:  tx.Gas(),
+		GasFeeCap: (*hexutil.Big)(tx.GasFeeCap()),
+		GasTipCap: (*hexutil.Big)(tx.GasTipCap()),
+		MsgTypes:  opts.MsgTypes,
+		Path:      opts.Path,
+	}
+}
+
+// computeHash returns the hash of the entry except for its `hash` field
+func (e auditEntry) computeHash() (common.Hash, error) {
+	e.Hash = common.Hash{}
+	bz, err := json.Marshal(e)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return gethcrypto.Keccak256Hash(bz), nil
+}
+
+// auditLog appends entries to a hash-chained JSONL file, which is shared by the relayer processes of the paths using the chain.
+// All methods are no-op if the receiver is nil.
+type auditLog struct {
+	mu   sync.Mutex
+	path string
+}
+
+// newAuditLog returns the audit log at `path`, which is created at the first append if it doesn't exist.
+// The existing entries are not read here so that a broken entry doesn't stop the relayer; use `audit verify` to check them.
+func newAuditLog(path string) *auditLog {
+	return &auditLog{path: path}
+}
+
+// Append chains the entry to the last one and writes it to the file.
+// The last entry is read under the file lock because the other processes may have appended entries.
+func (l *auditLog) Append(e auditEntry) error {
+	if l == nil {
+		return nil
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	lock := flock.New(l.path + ".lock")
+	if err := lock.Lock(); err != nil {
+		return fmt.Errorf("failed to lock audit log: %v", err)
+	}
+	defer lock.Unlock()
+
+	prevHash, err := readLastAuditHash(l.path)
+	if err != nil {
+		return err
+	}
+	e.PrevHash = prevHash
+	hash, err := e.computeHash()
+	if err != nil {
+		return err
+	}
+	e.Hash = hash
+	bz, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+
+	f, err := os.OpenFile(l.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	if _, err := f.Write(append(bz, '\n')); err != nil {
+		return err
+	}
+	// the entry must be persisted before the signed tx is used
+	return f.Sync()
+}
+
+// readLastAuditHash returns the hash of the last entry in the audit log, or zero if the log is empty.
+// The file is read backwards from its end so that only the last line is decoded.
+func readLastAuditHash(path string) (common.Hash, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return common.Hash{}, nil
+		}
+		return common.Hash{}, err
+	}
+	defer f.Close()
+	info, err := f.Stat()
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	const chunkSize = 4096
+	var tail []byte
+	for offset := info.Size(); offset > 0; {
+		n := min(chunkSize, offset)
+		offset -= n
+		chunk := make([]byte, n)
+		if _, err := f.ReadAt(chunk, offset); err != nil {
+			return common.Hash{}, err
+		}
+		tail = append(chunk, tail...)
+		if bytes.LastIndexByte(bytes.TrimRight(tail, "\n"), '\n') >= 0 {
+			break
+		}
+	}
+	lastLine := bytes.TrimRight(tail, "\n")
+	lastLine = lastLine[bytes.LastIndexByte(lastLine, '\n')+1:]
+	if len(lastLine) == 0 {
+		return common.Hash{}, nil
+	}
+
+	var e auditEntry
+	if err := json.Unmarshal(lastLine, &e); err != nil {
+		return common.Hash{}, fmt.Errorf("failed to decode the last audit log entry: %v", err)
+	}
+	return e.Hash, nil
+}
+
+// readAuditLog returns all the entries in the audit log, or nil if the file doesn't exist
+func readAuditLog(path string) ([]auditEntry, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	defer f.Close()
+
+	var entries []auditEntry
+	scanner := bufio.NewScanner(f)
+	for line := 1; scanner.Scan(); line++ {
+		var e auditEntry
+		if err := json.Unmarshal(scanner.Bytes(), &e); err != nil {
+			return nil, fmt.Errorf("failed to decode audit log entry: line=%d, err=%v", line, err)
+		}
+		entries = append(entries, e)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+// verifyAuditChain checks that each entry has a valid hash and is chained to the previous one
+func verifyAuditChain(entries []auditEntry) error {
+	var prevHash common.Hash
+	for i, e := range entries {
+		if e.PrevHash != prevHash {
+			return fmt.Errorf("audit log chain is broken: line=%d, expected_prev_hash=%v, actual_prev_hash=%v", i+1, prevHash, e.PrevHash)
+		}
+		hash, err := e.computeHash()
+		if err != nil {
+			return err
+		}
+		if hash != e.Hash {
+			return fmt.Errorf("audit log entry is modified: line=%d, expected_hash=%v, actual_hash=%v", i+1, hash, e.Hash)
+		}
+		prevHash = e.Hash
+	}
+	return nil
+}
+
+// compareAuditEntry returns an error if the tx on chain differs from the entry
+func compareAuditEntry(e auditEntry, tx *gethtypes.Transaction, gethSigner gethtypes.Signer) error {
+	sender, err := gethtypes.Sender(gethSigner, tx)
+	if err != nil {
+		return fmt.Errorf("failed to recover sender: %v", err)
+	}
+	switch {
+	case sender != e.Signer:
+		return fmt.Errorf("signer mismatch: entry=%v, tx=%v", e.Signer, sender)
+	case tx.Nonce() != e.Nonce:
+		return fmt.Errorf("nonce mismatch: entry=%v, tx=%v", e.Nonce, tx.Nonce())
+	case (tx.To() == nil) != (e.To == nil) || (tx.To() != nil && *tx.To() != *e.To):
+		return fmt.Errorf("to mismatch: entry=%v, tx=%v", e.To, tx.To())
+	case hexutil.Encode(tx.Data()[:min(4, len(tx.Data()))]) != e.Selector.String():
+		return fmt.Errorf("selector mismatch: entry=%v, tx=0x%x", e.Selector, tx.Data()[:min(4, len(tx.Data()))])
+	case tx.Gas() != e.GasLimit:
+		return fmt.Errorf("gas limit mismatch: entry=%v, tx=%v", e.GasLimit, tx.Gas())
+	case e.GasFeeCap == nil || tx.GasFeeCap().Cmp(e.GasFeeCap.ToInt()) != 0:
+		return fmt.Errorf("gas fee cap mismatch: entry=%v, tx=%v", e.GasFeeCap, tx.GasFeeCap())
+	case e.GasTipCap == nil || tx.GasTipCap().Cmp(e.GasTipCap.ToInt()) != 0:
+		return fmt.Errorf("gas tip cap mismatch: entry=%v, tx=%v", e.GasTipCap, tx.GasTipCap())
+	}
+	return nil
+}
+
+// openAuditLog opens the audit log in the data directory and makes all the signers of the chain append to it
+func (c *Chain) openAuditLog() error {
+	dir, err := c.ensureDataDirectory()
+	if err != nil {
+		return err
+	}
+	l := newAuditLog(filepath.Join(dir, auditLogFileName))
+	for _, s := range c.signers {
+		s.auditLog = l
+	}
+	if c.treasurySigner != nil {
+		c.treasurySigner.auditLog = l
+	}
+	return nil
+}
+
+// VerifyAuditLog checks the hash chain of the audit log and compares its entries with the txs on chain.
+// Entries whose txs are not found on chain (e.g. replaced or dropped txs) are reported but not regarded as errors.
+func (c *Chain) VerifyAuditLog(ctx context.Context, out io.Writer) error {
+	dir, err := c.ensureDataDirectory()
+	if err != nil {
+		return err
+	}
+	entries, err := readAuditLog(filepath.Join(dir, auditLogFileName))
+	if err != nil {
+		return err
+	}
+	if err := verifyAuditChain(entries); err != nil {
+		return err
+	}
+
+	var notFound, mismatched int
+	for i, e := range entries {
+		tx, _, err := c.client.TransactionByHash(ctx, e.TxHash)
+		if errors.Is(err, ethereum.NotFound) {
+			notFound++
+			fmt.Fprintf(out, "line %d: tx not found on chain: tx_hash=%v\n", i+1, e.TxHash)
+			continue
+		} else if err != nil {
+			return fmt.Errorf("failed to get tx: tx_hash=%v, err=%v", e.TxHash, err)
+		}
+		if err := compareAuditEntry(e, tx, c.ethereumSigner.gethSigner); err != nil {
+			mismatched++
+			fmt.Fprintf(out, "line %d: tx differs from the entry: tx_hash=%v, %v\n", i+1, e.TxHash, err)
+		}
+	}
+	fmt.Fprintf(out, "entries: %d, not found: %d, mismatched: %d\n", len(entries), notFound, mismatched)
+	if mismatched > 0 {
+		return fmt.Errorf("%d entries differ from the txs on chain", mismatched)
+	}
+	return nil
+}
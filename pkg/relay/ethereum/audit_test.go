@@ -0,0 +1,183 @@
+package ethereum
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	chantypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	"github.com/ethereum/go-ethereum/common"
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/hyperledger-labs/yui-relayer/core"
+	"github.com/hyperledger-labs/yui-relayer/log"
+	"github.com/stretchr/testify/require"
+)
+
+// auditTestEthService returns the registered txs by their hashes
+type auditTestEthService struct {
+	testEthService
+	txs map[common.Hash]*gethtypes.Transaction
+}
+
+func (s *auditTestEthService) GetTransactionByHash(txHash common.Hash) (*gethtypes.Transaction, error) {
+	return s.txs[txHash], nil
+}
+
+func newAuditTestChain(t *testing.T) *Chain {
+	c := newTestChain(t)
+	c.homePath = t.TempDir()
+	c.config.ChainId = "ibc0"
+	c.pathEnd = &core.PathEnd{ChainID: "ibc0", ClientID: "client-0", ConnectionID: "connection-0", ChannelID: "channel-0", PortID: "transfer"}
+	require.NoError(t, c.openAuditLog())
+	return c
+}
+
+func auditLogPath(t *testing.T, c *Chain) string {
+	dir, err := c.ensureDataDirectory()
+	require.NoError(t, err)
+	return filepath.Join(dir, auditLogFileName)
+}
+
+func TestAuditLog(t *testing.T) {
+	if err := log.InitLogger("DEBUG", "text", "null", false); err != nil {
+		t.Fatal(err)
+	}
+	c := newAuditTestChain(t)
+
+	msgs := []sdk.Msg{
+		&chantypes.MsgRecvPacket{Packet: chantypes.Packet{Sequence: 1}},
+		&chantypes.MsgRecvPacket{Packet: chantypes.Packet{Sequence: 2}},
+	}
+	var txs []*gethtypes.Transaction
+	for _, multicall := range []bool{true, false} {
+		if !multicall {
+			c.multicall3 = nil
+		}
+		iter := NewCallIter(msgs, false)
+		for !iter.End() {
+			built, err := iter.BuildTx(context.Background(), c)
+			require.NoError(t, err)
+			txs = append(txs, built.tx)
+			iter.Next(built.count)
+		}
+	}
+	require.Len(t, txs, 3)
+
+	// only the signed txs are recorded, not the ones built for gas estimation
+	entries, err := readAuditLog(auditLogPath(t, c))
+	require.NoError(t, err)
+	require.Len(t, entries, 3)
+	require.NoError(t, verifyAuditChain(entries))
+	for i, e := range entries {
+		require.Equal(t, txs[i].Hash(), e.TxHash)
+		require.Equal(t, c.ethereumSigner.Address(), e.Signer)
+		require.Equal(t, txs[i].Gas(), e.GasLimit)
+		require.Equal(t, c.pathEnd, e.Path)
+		require.Equal(t, txs[i].Data()[:4], []byte(e.Selector))
+	}
+	require.Equal(t, []string{"*types.MsgRecvPacket", "*types.MsgRecvPacket"}, entries[0].MsgTypes)
+	require.Equal(t, []string{"*types.MsgRecvPacket"}, entries[1].MsgTypes)
+
+	// the chain continues after reopening the log
+	require.NoError(t, c.openAuditLog())
+	_, err = c.ethereumSigner.Sign(c.ethereumSigner.Address(), txs[0])
+	require.NoError(t, err)
+	entries, err = readAuditLog(auditLogPath(t, c))
+	require.NoError(t, err)
+	require.Len(t, entries, 4)
+	require.NoError(t, verifyAuditChain(entries))
+
+	// modification and removal of entries are detected
+	bz, err := os.ReadFile(auditLogPath(t, c))
+	require.NoError(t, err)
+	lines := strings.SplitAfter(strings.TrimSuffix(string(bz), "\n"), "\n")
+	for _, tampered := range []string{
+		strings.Join(append(append([]string{}, lines[:1]...), lines[2:]...), ""),
+		strings.Replace(string(bz), `"nonce":1`, `"nonce":2`, 1),
+	} {
+		require.NoError(t, os.WriteFile(auditLogPath(t, c), []byte(tampered), 0600))
+		entries, err := readAuditLog(auditLogPath(t, c))
+		require.NoError(t, err)
+		require.Error(t, verifyAuditChain(entries))
+	}
+}
+
+func TestVerifyAuditLog(t *testing.T) {
+	if err := log.InitLogger("DEBUG", "text", "null", false); err != nil {
+		t.Fatal(err)
+	}
+	c := newAuditTestChain(t)
+	to := common.HexToAddress("0x01")
+	addr := c.ethereumSigner.Address()
+
+	var txs []*gethtypes.Transaction
+	for nonce := uint64(0); nonce < 2; nonce++ {
+		tx, err := c.ethereumSigner.Sign(addr, gethtypes.NewTx(&gethtypes.LegacyTx{Nonce: nonce, GasPrice: common.Big1, Gas: 21000, To: &to, Data: []byte{1, 2, 3, 4}}))
+		require.NoError(t, err)
+		txs = append(txs, tx)
+	}
+
+	// the second tx is not included in the chain
+	service := &auditTestEthService{txs: map[common.Hash]*gethtypes.Transaction{txs[0].Hash(): txs[0]}}
+	withTestEthService(t, c, service)
+
+	var out bytes.Buffer
+	require.NoError(t, c.VerifyAuditLog(context.Background(), &out))
+	require.Contains(t, out.String(), "entries: 2, not found: 1, mismatched: 0")
+
+	// an entry rewritten along with the hash chain is detected by the comparison with the chain
+	entries, err := readAuditLog(auditLogPath(t, c))
+	require.NoError(t, err)
+	require.NoError(t, os.Remove(auditLogPath(t, c)))
+	require.NoError(t, c.openAuditLog())
+	entries[0].GasLimit = 30000
+	for _, e := range entries {
+		require.NoError(t, c.ethereumSigner.auditLog.Append(e))
+	}
+	out.Reset()
+	require.Error(t, c.VerifyAuditLog(context.Background(), &out))
+	require.Contains(t, out.String(), "gas limit mismatch")
+}
+
+func TestAuditLogSharedByProcesses(t *testing.T) {
+	if err := log.InitLogger("DEBUG", "text", "null", false); err != nil {
+		t.Fatal(err)
+	}
+	c := newAuditTestChain(t)
+	to := common.HexToAddress("0x01")
+	addr := c.ethereumSigner.Address()
+	newTx := func(nonce uint64) *gethtypes.Transaction {
+		return gethtypes.NewTx(&gethtypes.LegacyTx{Nonce: nonce, GasPrice: common.Big1, Gas: 21000, To: &to})
+	}
+
+	// the entries appended by another process sharing the data directory are chained
+	other := newAuditLog(auditLogPath(t, c))
+	for nonce := uint64(0); nonce < 4; nonce++ {
+		if nonce%2 == 0 {
+			_, err := c.ethereumSigner.Sign(addr, newTx(nonce))
+			require.NoError(t, err)
+		} else {
+			require.NoError(t, other.Append(newAuditEntry(addr, newTx(nonce), SignOptions{})))
+		}
+	}
+	entries, err := readAuditLog(auditLogPath(t, c))
+	require.NoError(t, err)
+	require.Len(t, entries, 4)
+	require.NoError(t, verifyAuditChain(entries))
+
+	// a broken entry doesn't stop opening the log and signing, but it is detected by the verification
+	bz, err := os.ReadFile(auditLogPath(t, c))
+	require.NoError(t, err)
+	lines := strings.SplitAfter(string(bz), "\n")
+	lines[1] = "{broken\n"
+	require.NoError(t, os.WriteFile(auditLogPath(t, c), []byte(strings.Join(lines, "")), 0600))
+	require.NoError(t, c.openAuditLog())
+	_, err = c.ethereumSigner.Sign(addr, newTx(4))
+	require.NoError(t, err)
+	require.Error(t, c.VerifyAuditLog(context.Background(), io.Discard))
+}
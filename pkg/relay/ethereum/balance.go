@@ -0,0 +1,151 @@
+package ethereum
+
+import (
+	"fmt"
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/hyperledger-labs/yui-relayer/core"
+
+	"github.com/datachainlab/ethereum-ibc-relay-chain/pkg/contract/ierc20"
+	"github.com/datachainlab/ethereum-ibc-relay-chain/pkg/contract/multicall3"
+)
+
+// erc20Denom returns the denom that represents the balance of the ERC-20 token in sdk.Coins
+func erc20Denom(token common.Address) string {
+	return "erc20/" + token.Hex()
+}
+
+// QueryBalance returns the native token balance (in the configured balance denom) and
+// the balances of the configured ERC-20 tokens of the address.
+// The balances are queried in a single eth_call through multicall3 if it is available.
+func (c *Chain) QueryBalance(ctx core.QueryContext, address sdk.AccAddress) (sdk.Coins, error) {
+	addr := common.BytesToAddress(address)
+	tokens := make([]common.Address, len(c.config.Erc20TokenAddresses))
+	for i, token := range c.config.Erc20TokenAddresses {
+		tokens[i] = common.HexToAddress(token)
+	}
+
+	var (
+		native   *big.Int
+		balances []*big.Int
+		err      error
+	)
+	if c.multicall3 != nil {
+		native, balances, err = c.queryBalancesWithMulticall3(ctx, addr, tokens)
+	} else {
+		native, balances, err = c.queryBalances(ctx, addr, tokens)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	unit, err := c.config.BalanceDenomUnit()
+	if err != nil {
+		return nil, err
+	}
+	// sdk.NewCoins is not used because it panics on invalid coins such as duplicate denoms
+	coins := sdk.Coins{}
+	if native = new(big.Int).Div(native, unit); native.Sign() != 0 {
+		coins = append(coins, sdk.Coin{Denom: c.config.BalanceDenomOrDefault(), Amount: sdkmath.NewIntFromBigInt(native)})
+	}
+	for i, token := range tokens {
+		if balances[i].Sign() != 0 {
+			coins = append(coins, sdk.Coin{Denom: erc20Denom(token), Amount: sdkmath.NewIntFromBigInt(balances[i])})
+		}
+	}
+	coins = coins.Sort()
+	if err := coins.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid balances: %v", err)
+	}
+	return coins, nil
+}
+
+func (c *Chain) queryBalances(ctx core.QueryContext, addr common.Address, tokens []common.Address) (*big.Int, []*big.Int, error) {
+	opts := c.callOptsFromQueryContext(ctx)
+	native, err := c.client.BalanceAt(ctx.Context(), addr, opts.BlockNumber)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get balance: %w", err)
+	}
+
+	balances := make([]*big.Int, len(tokens))
+	for i, token := range tokens {
+		erc20, err := ierc20.NewIerc20(token, c.client)
+		if err != nil {
+			return nil, nil, err
+		}
+		if balances[i], err = erc20.BalanceOf(opts, addr); err != nil {
+			return nil, nil, fmt.Errorf("failed to get balance of token %s: %w", token, err)
+		}
+	}
+	return native, balances, nil
+}
+
+func (c *Chain) queryBalancesWithMulticall3(ctx core.QueryContext, addr common.Address, tokens []common.Address) (*big.Int, []*big.Int, error) {
+	multicall3ABI, err := multicall3.Multicall3MetaData.GetAbi()
+	if err != nil {
+		return nil, nil, err
+	}
+	erc20ABI, err := ierc20.Ierc20MetaData.GetAbi()
+	if err != nil {
+		return nil, nil, err
+	}
+	multicall3Addr := c.config.Multicall3AddressAsAddress()
+
+	// the first call gets the native token balance and the rest get the token balances
+	calls := make([]multicall3.Multicall3Call3, 0, len(tokens)+1)
+	callData, err := multicall3ABI.Pack("getEthBalance", addr)
+	if err != nil {
+		return nil, nil, err
+	}
+	calls = append(calls, multicall3.Multicall3Call3{Target: multicall3Addr, CallData: callData})
+	for _, token := range tokens {
+		callData, err := erc20ABI.Pack("balanceOf", addr)
+		if err != nil {
+			return nil, nil, err
+		}
+		calls = append(calls, multicall3.Multicall3Call3{Target: token, CallData: callData})
+	}
+
+	// aggregate3 is not a view function, but it can be executed by eth_call
+	var out []interface{}
+	contract := bind.NewBoundContract(multicall3Addr, *multicall3ABI, c.client, c.client, c.client)
+	if err := contract.Call(c.callOptsFromQueryContext(ctx), &out, "aggregate3", calls); err != nil {
+		return nil, nil, fmt.Errorf("failed to call aggregate3: %w", err)
+	}
+	results := *abi.ConvertType(out[0], new([]multicall3.Multicall3Result)).(*[]multicall3.Multicall3Result)
+	if len(results) != len(calls) {
+		return nil, nil, fmt.Errorf("unexpected number of aggregate3 results: expected=%d, actual=%d", len(calls), len(results))
+	}
+
+	balances := make([]*big.Int, len(results))
+	for i, result := range results {
+		var err error
+		if i == 0 {
+			balances[i], err = unpackBalance(multicall3ABI.Unpack("getEthBalance", result.ReturnData))
+		} else {
+			balances[i], err = unpackBalance(erc20ABI.Unpack("balanceOf", result.ReturnData))
+		}
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to unpack the result of call %d: %w", i, err)
+		}
+	}
+	return balances[0], balances[1:], nil
+}
+
+func unpackBalance(out []interface{}, err error) (*big.Int, error) {
+	if err != nil {
+		return nil, err
+	} else if len(out) != 1 {
+		return nil, fmt.Errorf("unexpected number of outputs: %d", len(out))
+	}
+	balance, ok := out[0].(*big.Int)
+	if !ok {
+		return nil, fmt.Errorf("unexpected output type: %T", out[0])
+	}
+	return balance, nil
+}
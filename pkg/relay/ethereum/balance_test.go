@@ -0,0 +1,118 @@
+package ethereum
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/hyperledger-labs/yui-relayer/core"
+	"github.com/stretchr/testify/require"
+
+	"github.com/datachainlab/ethereum-ibc-relay-chain/pkg/contract/ierc20"
+	"github.com/datachainlab/ethereum-ibc-relay-chain/pkg/contract/multicall3"
+)
+
+// balanceTestEthService serves the native token balance and the ERC-20 balances
+// both directly and through multicall3 deployed at `multicall3`
+type balanceTestEthService struct {
+	testEthService
+	t          *testing.T
+	multicall3 common.Address
+	native     *big.Int
+	tokens     map[common.Address]*big.Int
+	calls      []common.Address
+}
+
+func (s *balanceTestEthService) GetBalance(address common.Address, block string) (*hexutil.Big, error) {
+	return (*hexutil.Big)(s.native), nil
+}
+
+func (s *balanceTestEthService) Call(args map[string]interface{}, block string) (hexutil.Bytes, error) {
+	to := common.HexToAddress(args["to"].(string))
+	input, err := hexutil.Decode(args["input"].(string))
+	require.NoError(s.t, err)
+	s.calls = append(s.calls, to)
+	return s.call(to, input), nil
+}
+
+func (s *balanceTestEthService) call(to common.Address, input []byte) []byte {
+	multicall3ABI, err := multicall3.Multicall3MetaData.GetAbi()
+	require.NoError(s.t, err)
+	erc20ABI, err := ierc20.Ierc20MetaData.GetAbi()
+	require.NoError(s.t, err)
+
+	if to == s.multicall3 {
+		method, err := multicall3ABI.MethodById(input[:4])
+		require.NoError(s.t, err)
+		switch method.Name {
+		case "getEthBalance":
+			out, err := method.Outputs.Pack(s.native)
+			require.NoError(s.t, err)
+			return out
+		case "aggregate3":
+			args, err := method.Inputs.Unpack(input[4:])
+			require.NoError(s.t, err)
+			calls := *abi.ConvertType(args[0], new([]multicall3.Multicall3Call3)).(*[]multicall3.Multicall3Call3)
+			results := make([]multicall3.Multicall3Result, len(calls))
+			for i, call := range calls {
+				results[i] = multicall3.Multicall3Result{Success: true, ReturnData: s.call(call.Target, call.CallData)}
+			}
+			out, err := method.Outputs.Pack(results)
+			require.NoError(s.t, err)
+			return out
+		}
+	}
+	balance, ok := s.tokens[to]
+	require.True(s.t, ok, "unexpected call: %v", to)
+	out, err := erc20ABI.Methods["balanceOf"].Outputs.Pack(balance)
+	require.NoError(s.t, err)
+	return out
+}
+
+func TestQueryBalance(t *testing.T) {
+	token1 := common.HexToAddress("0x10")
+	token2 := common.HexToAddress("0x20")
+	service := &balanceTestEthService{
+		t:          t,
+		multicall3: common.HexToAddress("0x02"),
+		native:     big.NewInt(3_000_000_001),
+		tokens:     map[common.Address]*big.Int{token1: big.NewInt(100), token2: big.NewInt(0)},
+	}
+	c := newTestChain(t)
+	withTestEthService(t, c, service)
+	c.config.Multicall3Address = service.multicall3.Hex()
+	c.config.BalanceDenom = "gwei"
+	c.config.Erc20TokenAddresses = []string{token2.Hex(), token1.Hex()}
+
+	// the native balance is truncated to the denom and the zero balance is omitted
+	expected := sdk.NewCoins(
+		sdk.NewCoin("gwei", sdkmath.NewInt(3)),
+		sdk.NewCoin(erc20Denom(token1), sdkmath.NewInt(100)),
+	)
+	ctx := core.NewQueryContext(context.Background(), clienttypes.NewHeight(0, 0))
+	for _, multicall := range []bool{true, false} {
+		if !multicall {
+			c.multicall3 = nil
+		}
+		service.calls = nil
+		coins, err := c.QueryBalance(ctx, common.HexToAddress("0x03").Bytes())
+		require.NoError(t, err)
+		require.Equal(t, expected, coins)
+		if multicall {
+			require.Equal(t, []common.Address{service.multicall3}, service.calls)
+		} else {
+			require.Equal(t, []common.Address{token2, token1}, service.calls)
+		}
+	}
+
+	// duplicate tokens are rejected by the config validation
+	config := c.config
+	config.Erc20TokenAddresses = []string{token1.Hex(), "0x0000000000000000000000000000000000000010"}
+	require.ErrorContains(t, config.Validate(), `"erc20_token_addresses[1]" duplicates erc20_token_addresses[0]`)
+}
@@ -0,0 +1,187 @@
+package ethereum
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
+)
+
+const feeBudgetFileName = "fee_budget.json"
+
+type feeBudgetWindow struct {
+	name     string
+	duration time.Duration
+	limit    *big.Int
+}
+
+type feeRecord struct {
+	Time time.Time `json:"time"`
+	Fee  *big.Int  `json:"fee"`
+}
+
+// feeBudget tracks tx fees paid by the relayer account in rolling windows.
+// If `path` is set, the records are kept in the file shared by the relayer processes using the data directory,
+// so that the budget is not reset by restarts.
+// All methods are no-op if the receiver is nil.
+type feeBudget struct {
+	mu      sync.Mutex
+	path    string
+	windows []feeBudgetWindow
+	// records in chronological order
+	records []feeRecord
+}
+
+func newFeeBudget(config *FeeBudgetConfig) *feeBudget {
+	if config == nil {
+		return nil
+	}
+	var windows []feeBudgetWindow
+	if limit := config.GetHourlyLimit(); limit.Sign() > 0 {
+		windows = append(windows, feeBudgetWindow{name: "hourly", duration: time.Hour, limit: limit})
+	}
+	if limit := config.GetDailyLimit(); limit.Sign() > 0 {
+		windows = append(windows, feeBudgetWindow{name: "daily", duration: 24 * time.Hour, limit: limit})
+	}
+	if len(windows) == 0 {
+		return nil
+	}
+	return &feeBudget{windows: windows}
+}
+
+// update calls `fn` with the records loaded from the file, and writes them back if `fn` returns true
+func (b *feeBudget) update(fn func() bool) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.path == "" {
+		fn()
+		return nil
+	}
+	return updateDataFile(b.path, &b.records, fn)
+}
+
+// Record records the fee paid at `now` and drops the records that are out of all the windows
+func (b *feeBudget) Record(now time.Time, fee *big.Int) error {
+	if b == nil {
+		return nil
+	}
+	var maxDuration time.Duration
+	for _, w := range b.windows {
+		maxDuration = max(maxDuration, w.duration)
+	}
+	return b.update(func() bool {
+		for len(b.records) > 0 && !b.records[0].Time.After(now.Add(-maxDuration)) {
+			b.records = b.records[1:]
+		}
+		b.records = append(b.records, feeRecord{Time: now, Fee: fee})
+		return true
+	})
+}
+
+// Check returns an error wrapping ErrFeeBudgetExceeded if the fees paid in any window reach its limit.
+// The error tells the time at which the window is rolled enough to send txs again.
+func (b *feeBudget) Check(now time.Time) error {
+	if b == nil {
+		return nil
+	}
+	var checkErr error
+	err := b.update(func() bool {
+		checkErr = b.check(now)
+		return false
+	})
+	if err != nil {
+		// txs are refused if the fees paid recently are unknown
+		return fmt.Errorf("failed to load fee budget: %v", err)
+	}
+	return checkErr
+}
+
+func (b *feeBudget) check(now time.Time) error {
+	for _, w := range b.windows {
+		var records []feeRecord
+		spent := new(big.Int)
+		for _, r := range b.records {
+			if r.Time.After(now.Add(-w.duration)) {
+				records = append(records, r)
+				spent.Add(spent, r.Fee)
+			}
+		}
+		if spent.Cmp(w.limit) < 0 {
+			continue
+		}
+
+		// find the earliest time at which the fees in the window fall below the limit
+		remaining := new(big.Int).Set(spent)
+		var resumeAt time.Time
+		for _, r := range records {
+			remaining.Sub(remaining, r.Fee)
+			if remaining.Cmp(w.limit) < 0 {
+				resumeAt = r.Time.Add(w.duration)
+				break
+			}
+		}
+		return fmt.Errorf("%w: window=%s, spent=%v, limit=%v, resume_at=%s", ErrFeeBudgetExceeded, w.name, spent, w.limit, resumeAt.Format(time.RFC3339))
+	}
+	return nil
+}
+
+// txFee returns the fee paid for the tx, or nil if the receipt doesn't have the effective gas price
+func txFee(receipt *gethtypes.Receipt) *big.Int {
+	if receipt.EffectiveGasPrice == nil {
+		return nil
+	}
+	return new(big.Int).Mul(new(big.Int).SetUint64(receipt.GasUsed), receipt.EffectiveGasPrice)
+}
+
+// checkSpendingLimits returns an error if the balance of the relayer account is below `min_balance`
+// or the fees paid recently exceed `fee_budget`
+func (c *Chain) checkSpendingLimits(ctx context.Context) error {
+	if minBalance := c.config.GetMinBalance(); minBalance.Sign() > 0 {
+		balance, err := c.client.BalanceAt(ctx, c.signerFor(ctx).Address(), nil)
+		if err != nil {
+			return fmt.Errorf("failed to get balance: %w", err)
+		} else if balance.Cmp(minBalance) < 0 {
+			return fmt.Errorf("%w: balance=%v, min_balance=%v", ErrInsufficientBalance, balance, minBalance)
+		}
+	}
+	return c.feeBudget.Check(time.Now())
+}
+
+// adminTxOpts returns the tx opts of the txs sent outside SendMsgs, which are refused by the spending limits as well.
+// The limits are not checked in generate-only mode because the relayer doesn't pay for the txs.
+func (c *Chain) adminTxOpts(ctx context.Context) (*bind.TransactOpts, error) {
+	if generateOnlyFrom(ctx) == nil {
+		if err := c.checkSpendingLimits(ctx); err != nil {
+			return nil, err
+		}
+	}
+	return c.TxOpts(ctx, true)
+}
+
+// recordTxFee records the fee paid for the tx in the fee budget
+func (c *Chain) recordTxFee(ctx context.Context, receipt *gethtypes.Receipt) {
+	if fee := txFee(receipt); fee != nil {
+		if err := c.feeBudget.Record(time.Now(), fee); err != nil {
+			c.GetChainLogger().WarnContext(ctx, "failed to record tx fee", "error", err)
+		}
+	}
+}
+
+// openFeeBudget makes the fee budget kept in the data directory
+func (c *Chain) openFeeBudget() error {
+	if c.feeBudget == nil {
+		return nil
+	}
+	dir, err := c.ensureDataDirectory()
+	if err != nil {
+		return err
+	}
+	c.feeBudget.path = filepath.Join(dir, feeBudgetFileName)
+	return nil
+}
@@ -0,0 +1,63 @@
+package ethereum
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/hyperledger-labs/yui-relayer/log"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFeeBudget(t *testing.T) {
+	require.Nil(t, newFeeBudget(nil))
+	require.Nil(t, newFeeBudget(&FeeBudgetConfig{}))
+
+	budget := newFeeBudget(&FeeBudgetConfig{HourlyLimit: "100wei", DailyLimit: "150wei"})
+	require.NotNil(t, budget)
+
+	now := time.Unix(1_700_000_000, 0)
+	budget.Record(now, big.NewInt(60))
+	require.NoError(t, budget.Check(now))
+
+	// the hourly budget is exceeded until the first record is out of the window
+	budget.Record(now.Add(10*time.Minute), big.NewInt(50))
+	err := budget.Check(now.Add(10 * time.Minute))
+	require.True(t, errors.Is(err, ErrFeeBudgetExceeded))
+	require.Contains(t, err.Error(), "window=hourly")
+	require.NoError(t, budget.Check(now.Add(time.Hour)))
+
+	// the daily budget is exceeded until the first record is out of the window
+	budget.Record(now.Add(2*time.Hour), big.NewInt(50))
+	err = budget.Check(now.Add(2 * time.Hour))
+	require.True(t, errors.Is(err, ErrFeeBudgetExceeded))
+	require.Contains(t, err.Error(), "window=daily")
+	require.NoError(t, budget.Check(now.Add(24*time.Hour)))
+}
+
+func TestFeeBudgetPersisted(t *testing.T) {
+	if err := log.InitLogger("DEBUG", "text", "null", false); err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	c := newAuditTestChain(t)
+	c.config.FeeBudget = &FeeBudgetConfig{HourlyLimit: "100wei"}
+	c.feeBudget = newFeeBudget(c.config.FeeBudget)
+	require.NoError(t, c.openFeeBudget())
+
+	// the fees recorded before restarts or by the other processes count toward the budget
+	now := time.Now()
+	require.NoError(t, c.feeBudget.Record(now, big.NewInt(100)))
+	restarted := newFeeBudget(c.config.FeeBudget)
+	restarted.path = c.feeBudget.path
+	require.ErrorIs(t, restarted.Check(now), ErrFeeBudgetExceeded)
+
+	// admin txs are refused by the budget as well, except for unsigned txs
+	require.ErrorIs(t, c.CloseChannel(ctx, "transfer", "channel-0"), ErrFeeBudgetExceeded)
+	var out bytes.Buffer
+	require.NoError(t, c.CloseChannel(contextWithGenerateOnly(ctx, &generateOnly{out: &out}), "transfer", "channel-0"))
+	require.NotEmpty(t, out.Bytes())
+}
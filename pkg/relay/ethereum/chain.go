@@ -5,12 +5,13 @@ import (
 	"errors"
 	"fmt"
 	"math/big"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/avast/retry-go"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
 	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
 	conntypes "github.com/cosmos/ibc-go/v8/modules/core/03-connection/types"
 	chantypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
@@ -50,9 +51,26 @@ type Chain struct {
 	txMaxSize uint64
 
 	ethereumSigner EthereumSigner
+	// all the relayer accounts including the primary one (ethereumSigner)
+	signers      []*EthereumSigner
+	signerCursor atomic.Uint64
+	// signerMu is write-locked while the relayer accounts are rotated
+	signerMu sync.RWMutex
+	// ID of the last signer rotation applied by this process
+	appliedSignerRotation string
 
 	errorRepository ErrorRepository
 
+	feeBudget *feeBudget
+
+	// nil if the L1 data fee is not estimated
+	l2FeeModel L2FeeModel
+
+	treasurySigner *EthereumSigner
+	topUpMu        sync.Mutex
+	// relayer accounts whose top-up txs are waited for in the background
+	pendingTopUps map[common.Address]bool
+
 	// cache
 	connectionOpenedConfirmed bool
 	allowLCFunctions          *AllowLCFunctions
@@ -74,28 +92,21 @@ func NewChain(ctx context.Context, config ChainConfig) (*Chain, error) {
 	if err != nil {
 		return nil, err
 	}
-	ibcHandler, err := ibchandler.NewIbchandler(config.IBCAddress(), client)
-	if err != nil {
-		return nil, err
-	}
-
-	var multicall3_ *multicall3.Multicall3
-	if addr := config.Multicall3AddressAsAddress(); (addr != common.Address{}) {
-		contract, err := multicall3.NewMulticall3(addr, client)
+	var treasurySigner *EthereumSigner
+	if config.TopUp != nil {
+		bytesSigner, err := config.TopUp.TreasurySigner.GetCachedValue().(signer.SignerConfig).Build()
 		if err != nil {
-			return nil, err
+			return nil, fmt.Errorf("failed to build treasury signer: %v", err)
+		}
+		treasurySigner, err = NewEthereumSigner(ctx, bytesSigner, big.NewInt(int64(config.EthChainId)))
+		if err != nil {
+			return nil, fmt.Errorf("failed to build treasury ethereum signer: %v", err)
 		}
-		multicall3_ = contract
 	}
 
-	signer, err := config.Signer.GetCachedValue().(signer.SignerConfig).Build()
-
+	ethereumSigners, err := buildEthereumSigners(ctx, config.Signer.GetCachedValue().(signer.SignerConfig), big.NewInt(int64(config.EthChainId)))
 	if err != nil {
-		return nil, fmt.Errorf("failed to build signer: %v", err)
-	}
-	ethereumSigner, err := NewEthereumSigner(ctx, signer, big.NewInt(int64(config.EthChainId)))
-	if err != nil {
-		return nil, fmt.Errorf("failed to build ethreum signer: %v", err)
+		return nil, err
 	}
 
 	var alfs *AllowLCFunctions
@@ -105,6 +116,15 @@ func NewChain(ctx context.Context, config ChainConfig) (*Chain, error) {
 			return nil, fmt.Errorf("failed to build allowLcFunctions: %v", err)
 		}
 	}
+	if config.TxPolicy != nil {
+		policy, err := NewTxPolicy(config, alfs)
+		if err != nil {
+			return nil, fmt.Errorf("failed to build txPolicy: %v", err)
+		}
+		for _, s := range ethereumSigners {
+			s.policy = policy
+		}
+	}
 	errorRepository, err := CreateErrorRepository(config.AbiPaths)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create error repository: %v", err)
@@ -115,22 +135,53 @@ func NewChain(ctx context.Context, config ChainConfig) (*Chain, error) {
 		logger.InfoContext(ctx, fmt.Sprintf("txMaxSize is zero. set to %v", txMaxSize))
 	}
 
-	return &Chain{
+	// sent txs are tracked only for price bumps
+	var sentTxs *sentTxTracker
+	if config.PriceBump > 0 {
+		sentTxs = newSentTxTracker("")
+	}
+
+	chain := &Chain{
 		config:  config,
-		client:  &ChainClient{ETHClient: client},
+		client:  &ChainClient{ETHClient: client, sentTxs: sentTxs},
 		chainID: id,
 
-		ibcHandler: ibcHandler,
-		multicall3: multicall3_,
-
-		ethereumSigner: *ethereumSigner,
+		ethereumSigner: *ethereumSigners[0],
 
 		errorRepository: errorRepository,
 
 		txMaxSize: txMaxSize,
 
+		feeBudget: newFeeBudget(config.FeeBudget),
+
+		treasurySigner: treasurySigner,
+
 		allowLCFunctions: alfs,
-	}, nil
+	}
+	if err := chain.bindContracts(); err != nil {
+		return nil, err
+	}
+	chain.l2FeeModel = NewL2FeeModel(chain.client, &config)
+	// the primary signer is shared with the pool so that its state is consistent
+	chain.signers = append([]*EthereumSigner{&chain.ethereumSigner}, ethereumSigners[1:]...)
+	return chain, nil
+}
+
+// bindContracts binds the contracts to the chain client, so that all txs sent through them are tracked by it
+func (c *Chain) bindContracts() error {
+	ibcHandler, err := ibchandler.NewIbchandler(c.config.IBCAddress(), c.client)
+	if err != nil {
+		return err
+	}
+	c.ibcHandler = ibcHandler
+
+	c.multicall3 = nil
+	if addr := c.config.Multicall3AddressAsAddress(); (addr != common.Address{}) {
+		if c.multicall3, err = multicall3.NewMulticall3(addr, c.client); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 // Config returns ChainConfig
@@ -142,7 +193,16 @@ func (c *Chain) Config() ChainConfig {
 func (c *Chain) Init(homePath string, timeout time.Duration, codec codec.ProtoCodecMarshaler, debug bool) error {
 	c.homePath = homePath
 	c.codec = codec
-	return nil
+	if err := c.openAuditLog(); err != nil {
+		return err
+	}
+	if err := c.openSentTxJournal(); err != nil {
+		return err
+	}
+	if err := c.openFeeBudget(); err != nil {
+		return err
+	}
+	return c.restoreSignerRotation(context.Background())
 }
 
 // SetupForRelay ...
@@ -181,8 +241,9 @@ func (c *Chain) AverageBlockTime() time.Duration {
 
 // GetAddress returns the address of relayer
 func (c *Chain) GetAddress() (sdk.AccAddress, error) {
-	addr := make([]byte, 20)
-	return addr, nil
+	c.signerMu.RLock()
+	defer c.signerMu.RUnlock()
+	return c.ethereumSigner.Address().Bytes(), nil
 }
 
 // Marshaler returns the marshaler
@@ -533,16 +594,6 @@ func (c *Chain) QueryCanTransitionToFlushComplete(ctx core.QueryContext) (bool,
 	return c.ibcHandler.GetCanTransitionToFlushComplete(c.callOptsFromQueryContext(ctx), c.pathEnd.PortID, c.pathEnd.ChannelID)
 }
 
-// QueryBalance returns the amount of coins in the relayer account
-func (c *Chain) QueryBalance(ctx core.QueryContext, address sdk.AccAddress) (sdk.Coins, error) {
-	panic("not supported")
-}
-
-// QueryDenomTraces returns all the denom traces from a given chain
-func (c *Chain) QueryDenomTraces(ctx core.QueryContext, offset uint64, limit uint64) (*transfertypes.QueryDenomTracesResponse, error) {
-	panic("not supported")
-}
-
 func (c *Chain) callOptsFromQueryContext(ctx core.QueryContext) *bind.CallOpts {
 	return c.CallOpts(ctx.Context(), int64(ctx.Height().GetRevisionHeight()))
 }
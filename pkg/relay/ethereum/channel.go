@@ -0,0 +1,36 @@
+package ethereum
+
+import (
+	"context"
+
+	"github.com/datachainlab/ethereum-ibc-relay-chain/pkg/contract/ibchandler"
+	"github.com/hyperledger-labs/yui-relayer/log"
+)
+
+// CloseChannel sends a channelCloseInit tx to initiate the closing handshake of the channel
+func (c *Chain) CloseChannel(
+	ctx context.Context,
+	portID string,
+	channelID string,
+) error {
+	logger := c.GetChainLogger()
+	logger = &log.RelayLogger{Logger: logger.With(
+		logAttrPortID, portID,
+		logAttrChannelID, channelID,
+	)}
+
+	txOpts, err := c.adminTxOpts(ctx)
+	if err != nil {
+		return err
+	}
+
+	tx, err := c.ibcHandler.ChannelCloseInit(
+		txOpts,
+		ibchandler.IIBCChannelHandshakeMsgChannelCloseInit{
+			PortId:    portID,
+			ChannelId: channelID,
+		},
+	)
+
+	return processSendTxResult(ctx, logger, c, tx, err)
+}
@@ -0,0 +1,125 @@
+package ethereum
+
+import (
+	"context"
+	"testing"
+
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	chantypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/hyperledger-labs/yui-relayer/core"
+	"github.com/stretchr/testify/require"
+
+	"github.com/datachainlab/ethereum-ibc-relay-chain/pkg/contract/ibchandler"
+)
+
+var testPacket = chantypes.Packet{
+	Sequence:           1,
+	SourcePort:         "transfer",
+	SourceChannel:      "channel-0",
+	DestinationPort:    "transfer",
+	DestinationChannel: "channel-1",
+	Data:               []byte("data"),
+	TimeoutHeight:      clienttypes.NewHeight(1, 100),
+	TimeoutTimestamp:   200,
+}
+
+var testHandlerPacket = ibchandler.Packet{
+	Sequence:           1,
+	SourcePort:         "transfer",
+	SourceChannel:      "channel-0",
+	DestinationPort:    "transfer",
+	DestinationChannel: "channel-1",
+	Data:               []byte("data"),
+	TimeoutHeight:      ibchandler.HeightData{RevisionNumber: 1, RevisionHeight: 100},
+	TimeoutTimestamp:   200,
+}
+
+func TestTxChannelClose(t *testing.T) {
+	c := newTestChain(t)
+	ibcHandlerABI, err := ibchandler.IbchandlerMetaData.GetAbi()
+	require.NoError(t, err)
+
+	// decodeCalldata checks that the tx calls `name` of the IBC handler and decodes its argument into `v`
+	decodeCalldata := func(tx *gethtypes.Transaction, name string, v interface{}) {
+		require.Equal(t, common.HexToAddress("0x01"), *tx.To())
+		method, err := ibcHandlerABI.MethodById(tx.Data()[:4])
+		require.NoError(t, err)
+		require.Equal(t, name, method.Name)
+		args, err := method.Inputs.Unpack(tx.Data()[4:])
+		require.NoError(t, err)
+		require.Len(t, args, 1)
+		abi.ConvertType(args[0], v)
+	}
+	txOpts, err := c.TxOpts(context.Background(), true)
+	require.NoError(t, err)
+	txOpts.NoSend = true
+
+	tx, err := c.TxTimeoutOnClose(txOpts, &chantypes.MsgTimeoutOnClose{
+		Packet:                      testPacket,
+		ProofUnreceived:             []byte("proof unreceived"),
+		ProofClose:                  []byte("proof close"),
+		ProofHeight:                 clienttypes.NewHeight(1, 300),
+		NextSequenceRecv:            2,
+		CounterpartyUpgradeSequence: 3,
+	})
+	require.NoError(t, err)
+	var timeoutOnClose ibchandler.IIBCChannelPacketTimeoutMsgTimeoutOnClose
+	decodeCalldata(tx, "timeoutOnClose", &timeoutOnClose)
+	require.Equal(t, ibchandler.IIBCChannelPacketTimeoutMsgTimeoutOnClose{
+		Packet:                      testHandlerPacket,
+		ProofUnreceived:             []byte("proof unreceived"),
+		ProofClose:                  []byte("proof close"),
+		ProofHeight:                 ibchandler.HeightData{RevisionNumber: 1, RevisionHeight: 300},
+		NextSequenceRecv:            2,
+		CounterpartyUpgradeSequence: 3,
+	}, timeoutOnClose)
+
+	tx, err = c.TxChannelCloseInit(txOpts, &chantypes.MsgChannelCloseInit{PortId: "transfer", ChannelId: "channel-0"})
+	require.NoError(t, err)
+	var closeInit ibchandler.IIBCChannelHandshakeMsgChannelCloseInit
+	decodeCalldata(tx, "channelCloseInit", &closeInit)
+	require.Equal(t, ibchandler.IIBCChannelHandshakeMsgChannelCloseInit{PortId: "transfer", ChannelId: "channel-0"}, closeInit)
+
+	tx, err = c.TxChannelCloseConfirm(txOpts, &chantypes.MsgChannelCloseConfirm{
+		PortId:      "transfer",
+		ChannelId:   "channel-0",
+		ProofInit:   []byte("proof init"),
+		ProofHeight: clienttypes.NewHeight(1, 300),
+	})
+	require.NoError(t, err)
+	var closeConfirm ibchandler.IIBCChannelHandshakeMsgChannelCloseConfirm
+	decodeCalldata(tx, "channelCloseConfirm", &closeConfirm)
+	require.Equal(t, ibchandler.IIBCChannelHandshakeMsgChannelCloseConfirm{
+		PortId:      "transfer",
+		ChannelId:   "channel-0",
+		ProofInit:   []byte("proof init"),
+		ProofHeight: ibchandler.HeightData{RevisionNumber: 1, RevisionHeight: 300},
+	}, closeConfirm)
+}
+
+func TestParseTimeoutPacketEvent(t *testing.T) {
+	c := newTestChain(t)
+	data, err := abiTimeoutPacket.Inputs.NonIndexed().Pack(testHandlerPacket)
+	require.NoError(t, err)
+
+	// TimeoutPacket has no corresponding event in yui-relayer, so it is passed through as an unknown event
+	events, err := c.parseMsgEventLogs([]*gethtypes.Log{{
+		Address: common.HexToAddress("0x01"),
+		Topics:  []common.Hash{abiTimeoutPacket.ID},
+		Data:    data,
+	}})
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	unknown, ok := events[0].(*core.EventUnknown)
+	require.True(t, ok)
+	ev, ok := unknown.Value.(*ibchandler.IbchandlerTimeoutPacket)
+	require.True(t, ok)
+	require.Equal(t, testHandlerPacket, ev.Packet)
+
+	// malformed logs are rejected
+	_, err = c.parseMsgEventLogs([]*gethtypes.Log{{Topics: []common.Hash{abiTimeoutPacket.ID}, Data: data[:32]}})
+	require.Error(t, err)
+}
@@ -1,10 +1,13 @@
 package ethereum
 
 import (
+	"encoding/json"
 	"fmt"
 	"os"
 	"path/filepath"
 	"strconv"
+
+	"github.com/gofrs/flock"
 )
 
 type checkpointType string
@@ -60,3 +63,36 @@ func (c *Chain) saveCheckpoint(v uint64, cpType checkpointType) error {
 
 	return os.WriteFile(filepath.Join(dir, checkpointFileName(cpType)), bz, os.ModePerm)
 }
+
+// updateDataFile decodes the JSON file in the data directory into `v` and writes `v` back if `update` returns true.
+// The file is locked during the update because it is shared by the relayer processes of the paths using the chain.
+// `v` is left as is if the file doesn't exist.
+func updateDataFile(path string, v interface{}, update func() bool) error {
+	lock := flock.New(path + ".lock")
+	if err := lock.Lock(); err != nil {
+		return fmt.Errorf("failed to lock %s: %v", path, err)
+	}
+	defer lock.Unlock()
+
+	if bz, err := os.ReadFile(path); err == nil {
+		if err := json.Unmarshal(bz, v); err != nil {
+			return fmt.Errorf("failed to decode %s: %v", path, err)
+		}
+	} else if !os.IsNotExist(err) {
+		return err
+	}
+	if !update() {
+		return nil
+	}
+
+	bz, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	// the file is replaced by renaming not to be left partially written
+	tmpPath := path + ".tmp"
+	if err := os.WriteFile(tmpPath, bz, 0600); err != nil {
+		return err
+	}
+	return os.Rename(tmpPath, path)
+}
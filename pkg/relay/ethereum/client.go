@@ -7,6 +7,7 @@ import (
 	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
 
 	"github.com/datachainlab/ethereum-ibc-relay-chain/pkg/client"
 	"github.com/datachainlab/ethereum-ibc-relay-chain/pkg/client/txpool"
@@ -14,7 +15,7 @@ import (
 
 func (chain *Chain) CallOpts(ctx context.Context, height int64) *bind.CallOpts {
 	opts := &bind.CallOpts{
-		From:    chain.ethereumSigner.Address(),
+		From:    chain.signerFor(ctx).Address(),
 		Context: ctx,
 	}
 	if height > 0 {
@@ -24,11 +25,16 @@ func (chain *Chain) CallOpts(ctx context.Context, height int64) *bind.CallOpts {
 }
 
 func (chain *Chain) TxOpts(ctx context.Context, useLatestNonce bool) (*bind.TransactOpts, error) {
-	addr := chain.ethereumSigner.Address()
+	if g := generateOnlyFrom(ctx); g != nil {
+		return chain.generateOnlyTxOpts(ctx, g)
+	}
+
+	signer := chain.signerFor(ctx)
+	addr := signer.Address()
 
 	txOpts := &bind.TransactOpts{
 		From:    addr,
-		Signer:  chain.ethereumSigner.Sign,
+		Signer:  signer.SignerFn(ctx, SignOptions{Path: chain.pathEnd}),
 		Context: ctx,
 	}
 
@@ -59,8 +65,36 @@ type IChainClient interface {
 
 type ChainClient struct {
 	*client.ETHClient
+
+	// fallback of txpool_contentFrom for price bumps, which is nil if it is disabled
+	sentTxs *sentTxTracker
+}
+
+// SendTransaction sends the tx and remembers its fees for price bumps
+func (cl *ChainClient) SendTransaction(ctx context.Context, tx *gethtypes.Transaction) error {
+	if err := cl.ETHClient.SendTransaction(ctx, tx); err != nil {
+		return err
+	}
+	if sender, err := gethtypes.Sender(gethtypes.LatestSignerForChainID(tx.ChainId()), tx); err == nil {
+		if err := cl.sentTxs.Record(sender, tx); err != nil {
+			GetModuleLogger().WarnContext(ctx, "failed to journal sent tx", "error", err)
+		}
+	}
+	return nil
 }
 
+// GetMinimumRequiredFee returns the minimum fees to replace the pending tx with the nonce found by txpool_contentFrom.
+// If the node doesn't expose txpool_contentFrom, the journal of the txs sent by the relayer is used instead.
+// The journal is not used if txpool_contentFrom doesn't find the tx, which may have been evicted or dropped by the node.
 func (cl *ChainClient) GetMinimumRequiredFee(ctx context.Context, address common.Address, nonce uint64, priceBump uint64) (*txpool.RPCTransaction, *big.Int, *big.Int, error) {
-	return txpool.GetMinimumRequiredFee(ctx, cl.ETHClient.Client, address, nonce, priceBump)
+	oldTx, minFeeCap, minTipCap, err := txpool.GetMinimumRequiredFee(ctx, cl.ETHClient.Client, address, nonce, priceBump)
+	if cl.sentTxs == nil || err == nil {
+		return oldTx, minFeeCap, minTipCap, err
+	}
+	GetModuleLogger().WarnContext(ctx, "fall back to the sent txs because txpool_contentFrom is unavailable", "error", err)
+	oldTx, minFeeCap, minTipCap, err = cl.sentTxs.MinimumRequiredFee(address, nonce, priceBump)
+	if err != nil {
+		GetModuleLogger().WarnContext(ctx, "failed to load sent tx journal", "error", err)
+	}
+	return oldTx, minFeeCap, minTipCap, nil
 }
@@ -1,15 +1,25 @@
 package ethereum
 
 import (
+	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"math/big"
+	"os"
 	"strings"
 
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
 	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
 	chantypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
 	"github.com/datachainlab/ethereum-ibc-relay-chain/pkg/contract/iibcchannelupgradablemodule"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/hyperledger-labs/yui-relayer/config"
+	"github.com/hyperledger-labs/yui-relayer/core"
 	"github.com/hyperledger-labs/yui-relayer/coreutil"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
@@ -22,12 +32,149 @@ func ethereumCmd(ctx *config.Context) *cobra.Command {
 	}
 
 	cmd.AddCommand(
+		addressCmd(ctx),
+		auditCmd(ctx),
+		channelCmd(ctx),
 		channelUpgradeCmd(ctx),
+		fundCmd(ctx),
+		rotateSignerCmd(ctx),
+		transferCmd(ctx),
+		txCmd(ctx),
 	)
 
 	return &cmd
 }
 
+func addressCmd(ctx *config.Context) *cobra.Command {
+	cmd := cobra.Command{
+		Use:   "address [chain-id]",
+		Short: "show the addresses of the relayer accounts with their balances and nonces",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			chainID := args[0]
+
+			var ethChain *Chain
+			if chain, err := ctx.Config.GetChain(chainID); err != nil {
+				return err
+			} else if ethChain, err = coreutil.UnwrapChain[*Chain](chain); err != nil {
+				return err
+			}
+
+			latestHeight, err := ethChain.LatestHeight(cmd.Context())
+			if err != nil {
+				return err
+			}
+
+			// show all the accounts if the signer is a pool
+			out := cmd.OutOrStdout()
+			for i, signer := range ethChain.signers {
+				addr := signer.Address()
+				balance, err := ethChain.QueryBalance(core.NewQueryContext(cmd.Context(), latestHeight), addr.Bytes())
+				if err != nil {
+					return err
+				}
+				nonce, err := ethChain.client.NonceAt(cmd.Context(), addr, new(big.Int).SetUint64(latestHeight.GetRevisionHeight()))
+				if err != nil {
+					return err
+				}
+
+				if i > 0 {
+					fmt.Fprintln(out)
+				}
+				fmt.Fprintf(out, "address: %s\n", addr.Hex())
+				fmt.Fprintf(out, "balance: %s\n", balance)
+				fmt.Fprintf(out, "nonce: %d\n", nonce)
+			}
+			return nil
+		},
+	}
+
+	return &cmd
+}
+
+func auditCmd(ctx *config.Context) *cobra.Command {
+	cmd := cobra.Command{
+		Use:   "audit",
+		Short: "inspect the audit log of the txs signed by the relayer accounts",
+	}
+
+	cmd.AddCommand(
+		verifyAuditCmd(ctx),
+	)
+
+	return &cmd
+}
+
+func verifyAuditCmd(ctx *config.Context) *cobra.Command {
+	cmd := cobra.Command{
+		Use:   "verify [chain-id]",
+		Short: "check the hash chain of the audit log and compare its entries with the txs on chain",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			chainID := args[0]
+
+			var ethChain *Chain
+			if chain, err := ctx.Config.GetChain(chainID); err != nil {
+				return err
+			} else if ethChain, err = coreutil.UnwrapChain[*Chain](chain); err != nil {
+				return err
+			}
+
+			return ethChain.VerifyAuditLog(cmd.Context(), cmd.OutOrStdout())
+		},
+	}
+
+	return &cmd
+}
+
+func channelCmd(ctx *config.Context) *cobra.Command {
+	cmd := cobra.Command{
+		Use:     "channel",
+		Aliases: []string{"chan"},
+	}
+
+	cmd.AddCommand(
+		closeChannelCmd(ctx),
+	)
+
+	return &cmd
+}
+
+func closeChannelCmd(ctx *config.Context) *cobra.Command {
+	cmd := cobra.Command{
+		Use:  "close",
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			pathName := args[0]
+			chainID := args[1]
+
+			var ethChain *Chain
+			if chains, _, _, err := ctx.Config.ChainsFromPath(pathName); err != nil {
+				return err
+			} else if chain, ok := chains[chainID]; !ok {
+				return fmt.Errorf("chain not found: %s", chainID)
+			} else if ethChain, err = coreutil.UnwrapChain[*Chain](chain); err != nil {
+				return err
+			}
+
+			cmdCtx, err := generateOnlyContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			return ethChain.CloseChannel(
+				cmdCtx,
+				ethChain.pathEnd.PortID,
+				ethChain.pathEnd.ChannelID,
+			)
+		},
+	}
+
+	addGenerateOnlyFlags(&cmd)
+
+	return &cmd
+}
+
 func channelUpgradeCmd(ctx *config.Context) *cobra.Command {
 	cmd := cobra.Command{
 		Use:     "channel-upgrade",
@@ -103,8 +250,13 @@ func proposeUpgradeCmd(ctx *config.Context) *cobra.Command {
 				return err
 			}
 
+			cmdCtx, err := generateOnlyContext(cmd)
+			if err != nil {
+				return err
+			}
+
 			return ethChain.ProposeUpgrade(
-				cmd.Context(),
+				cmdCtx,
 				ethChain.pathEnd.PortID,
 				ethChain.pathEnd.ChannelID,
 				iibcchannelupgradablemodule.UpgradeFieldsData{
@@ -125,6 +277,7 @@ func proposeUpgradeCmd(ctx *config.Context) *cobra.Command {
 	cmd.Flags().String(flagVersion, "", "channel version applied for the new channel")
 	cmd.Flags().String(flagTimeoutHeight, "", "timeout height")
 	cmd.Flags().Uint64(flagTimeoutTimestamp, 0, "timeout timestamp")
+	addGenerateOnlyFlags(&cmd)
 
 	return &cmd
 }
@@ -157,8 +310,13 @@ func allowTransitionToFlushCompleteCmd(ctx *config.Context) *cobra.Command {
 				return err
 			}
 
+			cmdCtx, err := generateOnlyContext(cmd)
+			if err != nil {
+				return err
+			}
+
 			return ethChain.AllowTransitionToFlushComplete(
-				cmd.Context(),
+				cmdCtx,
 				ethChain.pathEnd.PortID,
 				ethChain.pathEnd.ChannelID,
 				upgradeSequence,
@@ -167,6 +325,7 @@ func allowTransitionToFlushCompleteCmd(ctx *config.Context) *cobra.Command {
 	}
 
 	cmd.Flags().Uint64(flagUpgradeSequence, 0, "upgrade sequence")
+	addGenerateOnlyFlags(&cmd)
 
 	return &cmd
 }
@@ -214,8 +373,13 @@ func proposeAppVersionCmd(ctx *config.Context) *cobra.Command {
 				return err
 			}
 
+			cmdCtx, err := generateOnlyContext(cmd)
+			if err != nil {
+				return err
+			}
+
 			return ethChain.ProposeAppVersion(
-				cmd.Context(),
+				cmdCtx,
 				ethChain.pathEnd.PortID,
 				version,
 				implementation,
@@ -227,10 +391,303 @@ func proposeAppVersionCmd(ctx *config.Context) *cobra.Command {
 	cmd.Flags().String(flagVersion, "", "app version")
 	cmd.Flags().BytesHex(flagImplementation, nil, "new implementation")
 	cmd.Flags().BytesHex(flagInitialCalldata, nil, "initial calldata")
+	addGenerateOnlyFlags(&cmd)
+
+	return &cmd
+}
+
+func fundCmd(ctx *config.Context) *cobra.Command {
+	cmd := cobra.Command{
+		Use:   "fund [chain-id]",
+		Short: "top up the relayer accounts from the treasury account up to the high watermark",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			chainID := args[0]
+
+			var ethChain *Chain
+			if chain, err := ctx.Config.GetChain(chainID); err != nil {
+				return err
+			} else if ethChain, err = coreutil.UnwrapChain[*Chain](chain); err != nil {
+				return err
+			}
+
+			cmdCtx, err := generateOnlyContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			for _, signer := range ethChain.signers {
+				if err := ethChain.TopUp(contextWithSigner(cmdCtx, signer), true); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	}
+
+	addGenerateOnlyFlags(&cmd)
+
+	return &cmd
+}
+
+func rotateSignerCmd(ctx *config.Context) *cobra.Command {
+	const (
+		flagSweep = "sweep"
+	)
+
+	cmd := cobra.Command{
+		Use:   "rotate-signer [chain-id] [signer-config-file]",
+		Short: "request the running relayer to switch to the signer config (in the same format as `signer` in the chain config) once no tx of the current accounts is pending",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			chainID := args[0]
+
+			sweep, err := cmd.Flags().GetBool(flagSweep)
+			if err != nil {
+				return err
+			}
+
+			bz, err := os.ReadFile(args[1])
+			if err != nil {
+				return err
+			}
+
+			var ethChain *Chain
+			if chain, err := ctx.Config.GetChain(chainID); err != nil {
+				return err
+			} else if ethChain, err = coreutil.UnwrapChain[*Chain](chain); err != nil {
+				return err
+			}
+
+			cmdCtx, err := generateOnlyContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			return ethChain.RequestSignerRotation(cmdCtx, bz, sweep)
+		},
+	}
+
+	cmd.Flags().Bool(flagSweep, false, "transfer the remaining funds of the current relayer accounts to the new primary account (subject to tx_policy if configured)")
+	// the nonces and the amounts of the unsigned sweep txs are fixed at generation, so the old accounts must not send txs until they are broadcast
+	cmd.Flags().Bool(flagGenerateOnly, false, "output the unsigned sweep txs of the current relayer accounts as JSON instead of making the relayer send them (requires --sweep)")
+	cmd.Flags().String(flagFrom, "", "the only account to sweep, used with --generate-only (default: all the current relayer accounts)")
+
+	return &cmd
+}
+
+func transferCmd(ctx *config.Context) *cobra.Command {
+	const (
+		flagTimeoutHeight       = "timeout-height"
+		flagTimeoutHeightOffset = "timeout-height-offset"
+	)
+
+	cmd := cobra.Command{
+		Use:   "transfer [path-name] [chain-id] [denom] [amount] [receiver]",
+		Short: "send tokens through the ICS-20 app bound to the port of the path",
+		Args:  cobra.ExactArgs(5),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			pathName := args[0]
+			chainID := args[1]
+			denom := args[2]
+			receiver := args[4]
+
+			amount, ok := sdkmath.NewIntFromString(args[3])
+			if !ok || !amount.IsPositive() {
+				return fmt.Errorf("invalid amount: %s", args[3])
+			}
+
+			var ethChain *Chain
+			var counterparty *core.ProvableChain
+			if chains, src, dst, err := ctx.Config.ChainsFromPath(pathName); err != nil {
+				return err
+			} else if chain, ok := chains[chainID]; !ok {
+				return fmt.Errorf("chain not found: %s", chainID)
+			} else if ethChain, err = coreutil.UnwrapChain[*Chain](chain); err != nil {
+				return err
+			} else if chainID == src {
+				counterparty = chains[dst]
+			} else {
+				counterparty = chains[src]
+			}
+
+			// get timeout height from flags, or calculate it from the latest height of the counterparty chain
+			var timeoutHeight clienttypes.Height
+			if s, err := cmd.Flags().GetString(flagTimeoutHeight); err != nil {
+				return err
+			} else if s != "" {
+				if timeoutHeight, err = clienttypes.ParseHeight(s); err != nil {
+					return err
+				}
+			} else if offset, err := cmd.Flags().GetUint64(flagTimeoutHeightOffset); err != nil {
+				return err
+			} else if latestHeight, err := counterparty.LatestHeight(cmd.Context()); err != nil {
+				return err
+			} else {
+				timeoutHeight = clienttypes.NewHeight(latestHeight.GetRevisionNumber(), latestHeight.GetRevisionHeight()+offset)
+			}
+
+			cmdCtx, err := generateOnlyContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			return ethChain.Transfer(cmdCtx, &transfertypes.MsgTransfer{
+				SourcePort:    ethChain.pathEnd.PortID,
+				SourceChannel: ethChain.pathEnd.ChannelID,
+				// NOTE: sdk.NewCoin cannot be used because an ERC-20 token address is not a valid denom in cosmos-sdk
+				Token:         sdk.Coin{Denom: denom, Amount: amount},
+				Sender:        ethChain.ethereumSigner.Address().Hex(),
+				Receiver:      receiver,
+				TimeoutHeight: timeoutHeight,
+			})
+		},
+	}
+
+	cmd.Flags().String(flagTimeoutHeight, "", "timeout height on the counterparty chain (e.g. 0-1000)")
+	cmd.Flags().Uint64(flagTimeoutHeightOffset, 1000, "timeout height offset from the latest height of the counterparty chain, used if timeout-height is not specified")
+	addGenerateOnlyFlags(&cmd)
+
+	return &cmd
+}
+
+func txCmd(ctx *config.Context) *cobra.Command {
+	cmd := cobra.Command{
+		Use:   "tx",
+		Short: "sign and broadcast txs generated with --generate-only",
+	}
+
+	cmd.AddCommand(
+		signTxCmd(ctx),
+		broadcastTxCmd(ctx),
+	)
+
+	return &cmd
+}
+
+func signTxCmd(ctx *config.Context) *cobra.Command {
+	const (
+		flagSignerConfig = "signer-config"
+	)
+
+	cmd := cobra.Command{
+		Use:   "sign [chain-id] [unsigned-tx-file]",
+		Short: "sign an unsigned tx generated with --generate-only offline with the relayer or treasury account of its sender, or with the account of --signer-config",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			chainID := args[0]
+
+			signerConfigPath, err := cmd.Flags().GetString(flagSignerConfig)
+			if err != nil {
+				return err
+			}
+
+			var ethChain *Chain
+			if chain, err := ctx.Config.GetChain(chainID); err != nil {
+				return err
+			} else if ethChain, err = coreutil.UnwrapChain[*Chain](chain); err != nil {
+				return err
+			}
+
+			bz, err := os.ReadFile(args[1])
+			if err != nil {
+				return err
+			}
+			var unsignedTx txArgs
+			if err := json.Unmarshal(bz, &unsignedTx); err != nil {
+				return fmt.Errorf("failed to decode unsigned tx: %v", err)
+			}
+
+			var tx *gethtypes.Transaction
+			if signerConfigPath == "" {
+				tx, err = ethChain.SignUnsignedTx(cmd.Context(), &unsignedTx)
+			} else if signerConfig, readErr := os.ReadFile(signerConfigPath); readErr != nil {
+				return readErr
+			} else {
+				tx, err = ethChain.SignUnsignedTxWithSignerConfig(cmd.Context(), &unsignedTx, signerConfig)
+			}
+			if err != nil {
+				return err
+			}
+			rawTx, err := tx.MarshalBinary()
+			if err != nil {
+				return err
+			}
+			fmt.Fprintln(cmd.OutOrStdout(), hexutil.Encode(rawTx))
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flagSignerConfig, "", "path to a signer config (in the same format as `signer` in the chain config) of the sender that is not configured for the chain, e.g. --from of --generate-only")
+
+	return &cmd
+}
+
+func broadcastTxCmd(ctx *config.Context) *cobra.Command {
+	cmd := cobra.Command{
+		Use:   "broadcast [chain-id] [signed-tx-hex]",
+		Short: "send a signed raw tx and wait for its receipt",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			chainID := args[0]
+
+			rawTx, err := hexutil.Decode(strings.TrimSpace(args[1]))
+			if err != nil {
+				return fmt.Errorf("invalid signed tx: %v", err)
+			}
+
+			var ethChain *Chain
+			if chain, err := ctx.Config.GetChain(chainID); err != nil {
+				return err
+			} else if ethChain, err = coreutil.UnwrapChain[*Chain](chain); err != nil {
+				return err
+			}
+
+			return ethChain.BroadcastTx(cmd.Context(), rawTx)
+		},
+	}
 
 	return &cmd
 }
 
+const (
+	flagGenerateOnly = "generate-only"
+	flagFrom         = "from"
+)
+
+// addGenerateOnlyFlags adds the flags to output the unsigned tx instead of sending it
+func addGenerateOnlyFlags(cmd *cobra.Command) {
+	cmd.Flags().Bool(flagGenerateOnly, false, "output the unsigned tx as JSON instead of signing and sending it")
+	cmd.Flags().String(flagFrom, "", "sender address of the unsigned tx, used with --generate-only (default: the relayer account)")
+}
+
+// generateOnlyContext returns the context of the command, which is bound to the generate-only state if --generate-only is set
+func generateOnlyContext(cmd *cobra.Command) (context.Context, error) {
+	generate, err := cmd.Flags().GetBool(flagGenerateOnly)
+	if err != nil {
+		return nil, err
+	}
+	from, err := cmd.Flags().GetString(flagFrom)
+	if err != nil {
+		return nil, err
+	}
+	if !generate {
+		if from != "" {
+			return nil, fmt.Errorf("--%s must be used with --%s", flagFrom, flagGenerateOnly)
+		}
+		return cmd.Context(), nil
+	}
+
+	g := &generateOnly{out: cmd.OutOrStdout()}
+	if from != "" {
+		if !common.IsHexAddress(from) {
+			return nil, fmt.Errorf("invalid address: %s", from)
+		}
+		g.from = common.HexToAddress(from)
+	}
+	return contextWithGenerateOnly(cmd.Context(), g), nil
+}
+
 func getOrderFromFlags(flags *pflag.FlagSet, flagName string) (chantypes.Order, error) {
 	s, err := flags.GetString(flagName)
 	if err != nil {
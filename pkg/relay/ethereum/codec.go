@@ -3,6 +3,7 @@ package ethereum
 import (
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/hyperledger-labs/yui-relayer/core"
+	"github.com/hyperledger-labs/yui-relayer/signer"
 )
 
 // RegisterInterfaces register the module interfaces to protobuf Any.
@@ -15,4 +16,10 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		(*core.MsgID)(nil),
 		&MsgID{},
 	)
+	registry.RegisterImplementations(
+		(*signer.SignerConfig)(nil),
+		&SignerPoolConfig{},
+		&KeystoreSignerConfig{},
+		&RemoteSignerConfig{},
+	)
 }
@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"math/big"
+	"net/url"
 	"strings"
 
 	"github.com/datachainlab/ethereum-ibc-relay-chain/pkg/utils"
@@ -24,6 +25,7 @@ var (
 
 const TxTypeAuto = "auto"
 const TxTypeLegacy = "legacy"
+const TxTypeAccessList = "access_list"
 const TxTypeDynamic = "dynamic"
 
 func (c ChainConfig) Build() (core.Chain, error) {
@@ -77,10 +79,10 @@ func (c ChainConfig) Validate() error {
 			errs = append(errs, fmt.Errorf("config attribute \"allow_lc_functions\" is invalid: %v", err))
 		}
 	}
-	if c.TxType != TxTypeAuto && c.TxType != TxTypeLegacy && c.TxType != TxTypeDynamic {
+	if c.TxType != TxTypeAuto && c.TxType != TxTypeLegacy && c.TxType != TxTypeAccessList && c.TxType != TxTypeDynamic {
 		errs = append(errs, fmt.Errorf("config attribute \"tx_type\" is invalid"))
 	}
-	if c.TxType == TxTypeDynamic {
+	if c.TxType == TxTypeDynamic || c.GasOracleOrDefault() == GasOracleFeeHistory {
 		if c.DynamicTxGasConfig == nil {
 			errs = append(errs, fmt.Errorf("config attribute \"dynamic_tx_gas_config\" is empty"))
 		} else {
@@ -89,6 +91,69 @@ func (c ChainConfig) Validate() error {
 			}
 		}
 	}
+	if c.LegacyTxGasConfig != nil {
+		if err := c.LegacyTxGasConfig.ValidateBasic(); err != nil {
+			errs = append(errs, fmt.Errorf("config attribute \"legacy_tx_gas_config\" is invalid: %v", err))
+		}
+	}
+	switch c.GasOracle {
+	case "", GasOracleNode, GasOracleFeeHistory:
+	case GasOracleGasStation:
+		if c.GasStation == nil {
+			errs = append(errs, fmt.Errorf("config attribute \"gas_station\" is empty"))
+		} else if err := c.GasStation.ValidateBasic(); err != nil {
+			errs = append(errs, fmt.Errorf("config attribute \"gas_station\" is invalid: %v", err))
+		}
+	default:
+		errs = append(errs, fmt.Errorf("config attribute \"gas_oracle\" is invalid"))
+	}
+	if !isEmpty(c.BalanceDenom) {
+		if _, err := c.BalanceDenomUnit(); err != nil {
+			errs = append(errs, fmt.Errorf("config attribute \"balance_denom\" is invalid: %v", err))
+		}
+	}
+	tokens := make(map[common.Address]int)
+	for i, addr := range c.Erc20TokenAddresses {
+		if !common.IsHexAddress(addr) {
+			errs = append(errs, fmt.Errorf("config attribute \"erc20_token_addresses[%d]\" should be hex address", i))
+		} else if j, ok := tokens[common.HexToAddress(addr)]; ok {
+			errs = append(errs, fmt.Errorf("config attribute \"erc20_token_addresses[%d]\" duplicates erc20_token_addresses[%d]", i, j))
+		} else {
+			tokens[common.HexToAddress(addr)] = i
+		}
+	}
+	if c.MinBalance != "" {
+		if _, err := utils.ParseEtherAmount(c.MinBalance); err != nil {
+			errs = append(errs, fmt.Errorf("config attribute \"min_balance\" is invalid: %v", err))
+		}
+	}
+	if c.FeeBudget != nil {
+		if err := c.FeeBudget.ValidateBasic(); err != nil {
+			errs = append(errs, fmt.Errorf("config attribute \"fee_budget\" is invalid: %v", err))
+		}
+	}
+	if c.TopUp != nil {
+		if err := c.TopUp.ValidateBasic(); err != nil {
+			errs = append(errs, fmt.Errorf("config attribute \"top_up\" is invalid: %v", err))
+		}
+	}
+	if c.TxPolicy != nil {
+		if err := c.TxPolicy.ValidateBasic(); err != nil {
+			errs = append(errs, fmt.Errorf("config attribute \"tx_policy\" is invalid: %v", err))
+		}
+	}
+	switch c.L2FeeModel {
+	case "", L2FeeModelOPStack, L2FeeModelArbitrum:
+	default:
+		errs = append(errs, fmt.Errorf("config attribute \"l2_fee_model\" is invalid"))
+	}
+	if c.MaxL1Fee != "" {
+		if isEmpty(c.L2FeeModel) {
+			errs = append(errs, fmt.Errorf("config attribute \"max_l1_fee\" requires \"l2_fee_model\""))
+		} else if _, err := utils.ParseEtherAmount(c.MaxL1Fee); err != nil {
+			errs = append(errs, fmt.Errorf("config attribute \"max_l1_fee\" is invalid: %v", err))
+		}
+	}
 	for i, path := range c.AbiPaths {
 		if isEmpty(path) {
 			errs = append(errs, fmt.Errorf("config attribute \"abi_paths[%d]\" is empty", i))
@@ -114,6 +179,11 @@ func (c ChainConfig) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
 	if err := unpacker.UnpackAny(c.Signer, new(signer.SignerConfig)); err != nil {
 		return fmt.Errorf("failed to unpack ChainConfig attribute \"signer\": %v", err)
 	}
+	if c.TopUp != nil {
+		if err := unpacker.UnpackAny(c.TopUp.TreasurySigner, new(signer.SignerConfig)); err != nil {
+			return fmt.Errorf("failed to unpack ChainConfig attribute \"top_up.treasury_signer\": %v", err)
+		}
+	}
 	return nil
 }
 
@@ -125,6 +195,23 @@ func (c ChainConfig) Multicall3AddressAsAddress() common.Address {
 	return common.HexToAddress(c.Multicall3Address)
 }
 
+// BalanceDenomOrDefault returns the denom of the native token balance, which defaults to wei
+func (c ChainConfig) BalanceDenomOrDefault() string {
+	if c.BalanceDenom == "" {
+		return "wei"
+	}
+	return c.BalanceDenom
+}
+
+// BalanceDenomUnit returns the amount of wei per unit of the balance denom
+func (c ChainConfig) BalanceDenomUnit() (*big.Int, error) {
+	denom := c.BalanceDenomOrDefault()
+	if denom != "wei" && denom != "gwei" && denom != "ether" {
+		return nil, fmt.Errorf("unknown denom: %s (acceptable: wei/gwei/ether)", denom)
+	}
+	return utils.ParseEtherAmount("1" + denom)
+}
+
 func (alf AllowLCFunctionsConfig) ValidateBasic() error {
 	if !common.IsHexAddress(alf.LcAddress) {
 		return fmt.Errorf("invalid contract address: %s", alf.LcAddress)
@@ -212,9 +299,105 @@ func (gsc *DynamicTxGasConfig) ValidateBasic() error {
 	if gsc.FeeHistoryRewardPercentile == 0 {
 		return fmt.Errorf("config attribute \"fee_history_reward_percentile\" is zero")
 	}
+	// eth_feeHistory of geth returns at most 1024 blocks
+	if gsc.FeeHistoryBlockCount > 1024 {
+		return fmt.Errorf("config attribute \"fee_history_block_count\" is too large: %d", gsc.FeeHistoryBlockCount)
+	}
+	seen := make(map[uint64]bool)
+	for i, tier := range gsc.UrgencyTiers {
+		if err := tier.ValidateBasic(); err != nil {
+			return fmt.Errorf("config attribute \"urgency_tiers[%d]\" is invalid: %v", i, err)
+		}
+		if seen[tier.MaxBlocksToTimeout] {
+			return fmt.Errorf("config attribute \"urgency_tiers[%d]\" is invalid: duplicate max_blocks_to_timeout: %d", i, tier.MaxBlocksToTimeout)
+		}
+		seen[tier.MaxBlocksToTimeout] = true
+	}
+	return nil
+}
+
+func (t *FeeUrgencyTier) ValidateBasic() error {
+	if t.MaxBlocksToTimeout == 0 {
+		return fmt.Errorf("config attribute \"max_blocks_to_timeout\" is zero")
+	}
+	if t.PriorityFeeMultiplier != nil {
+		if err := t.PriorityFeeMultiplier.Validate(); err != nil {
+			return fmt.Errorf("config attribute \"priority_fee_multiplier\" is invalid: %v", err)
+		}
+	}
+	if t.BaseFeeMultiplier != nil {
+		if err := t.BaseFeeMultiplier.Validate(); err != nil {
+			return fmt.Errorf("config attribute \"base_fee_multiplier\" is invalid: %v", err)
+		}
+	}
 	return nil
 }
 
+// GasOracleOrDefault returns `gas_oracle`, or the default oracle for `tx_type` if it is empty
+func (c ChainConfig) GasOracleOrDefault() string {
+	if c.GasOracle != "" {
+		return c.GasOracle
+	} else if c.TxType == TxTypeDynamic {
+		return GasOracleFeeHistory
+	}
+	return GasOracleNode
+}
+
+func (c *GasStationConfig) ValidateBasic() error {
+	if u, err := url.Parse(c.Url); err != nil {
+		return fmt.Errorf("config attribute \"url\" is invalid: %v", err)
+	} else if u.Scheme != "http" && u.Scheme != "https" {
+		return fmt.Errorf("config attribute \"url\" should be http or https URL")
+	}
+	if strings.TrimSpace(c.Speed) == "" {
+		return fmt.Errorf("config attribute \"speed\" is empty")
+	}
+	return nil
+}
+
+func (gsc *LegacyTxGasConfig) ValidateBasic() error {
+	if gsc.GasPriceRate == nil {
+		return fmt.Errorf("config attribute \"gas_price_rate\" is nil")
+	}
+	if err := gsc.GasPriceRate.Validate(); err != nil {
+		return fmt.Errorf("config attribute \"gas_price_rate\" is invalid: %v", err)
+	}
+	if gsc.LimitGasPrice != "" {
+		if _, err := utils.ParseEtherAmount(gsc.LimitGasPrice); err != nil {
+			return fmt.Errorf("config attribute \"limit_gas_price\" is invalid: %v", err)
+		}
+	}
+	if gsc.MinGasPrice != "" {
+		if _, err := utils.ParseEtherAmount(gsc.MinGasPrice); err != nil {
+			return fmt.Errorf("config attribute \"min_gas_price\" is invalid: %v", err)
+		}
+	}
+	if limit, minPrice := gsc.GetLimitGasPrice(), gsc.GetMinGasPrice(); limit.Sign() > 0 && limit.Cmp(minPrice) < 0 {
+		return fmt.Errorf("config attribute \"min_gas_price\" is greater than \"limit_gas_price\"")
+	}
+	return nil
+}
+
+func (c *LegacyTxGasConfig) GetLimitGasPrice() *big.Int {
+	if c.LimitGasPrice == "" {
+		return new(big.Int)
+	} else if limit, err := utils.ParseEtherAmount(c.LimitGasPrice); err != nil {
+		panic(err)
+	} else {
+		return limit
+	}
+}
+
+func (c *LegacyTxGasConfig) GetMinGasPrice() *big.Int {
+	if c.MinGasPrice == "" {
+		return new(big.Int)
+	} else if minPrice, err := utils.ParseEtherAmount(c.MinGasPrice); err != nil {
+		panic(err)
+	} else {
+		return minPrice
+	}
+}
+
 func (c *DynamicTxGasConfig) GetLimitPriorityFeePerGas() *big.Int {
 	if c.LimitPriorityFeePerGas == "" {
 		return new(big.Int)
@@ -234,3 +417,125 @@ func (c *DynamicTxGasConfig) GetLimitFeePerGas() *big.Int {
 		return limit
 	}
 }
+
+// GetMaxL1Fee returns the maximum L1 data fee of a tx, or zero if it is not configured
+func (c ChainConfig) GetMaxL1Fee() *big.Int {
+	if c.MaxL1Fee == "" {
+		return new(big.Int)
+	} else if maxL1Fee, err := utils.ParseEtherAmount(c.MaxL1Fee); err != nil {
+		panic(err)
+	} else {
+		return maxL1Fee
+	}
+}
+
+// GetMinBalance returns the minimum balance of the relayer account, or zero if it is not configured
+func (c ChainConfig) GetMinBalance() *big.Int {
+	if c.MinBalance == "" {
+		return new(big.Int)
+	} else if minBalance, err := utils.ParseEtherAmount(c.MinBalance); err != nil {
+		panic(err)
+	} else {
+		return minBalance
+	}
+}
+
+func (c *FeeBudgetConfig) ValidateBasic() error {
+	if c.HourlyLimit != "" {
+		if _, err := utils.ParseEtherAmount(c.HourlyLimit); err != nil {
+			return fmt.Errorf("config attribute \"hourly_limit\" is invalid: %v", err)
+		}
+	}
+	if c.DailyLimit != "" {
+		if _, err := utils.ParseEtherAmount(c.DailyLimit); err != nil {
+			return fmt.Errorf("config attribute \"daily_limit\" is invalid: %v", err)
+		}
+	}
+	return nil
+}
+
+// GetHourlyLimit returns the hourly fee limit, or zero if it is not configured
+func (c *FeeBudgetConfig) GetHourlyLimit() *big.Int {
+	if c.HourlyLimit == "" {
+		return new(big.Int)
+	} else if limit, err := utils.ParseEtherAmount(c.HourlyLimit); err != nil {
+		panic(err)
+	} else {
+		return limit
+	}
+}
+
+// GetDailyLimit returns the daily fee limit, or zero if it is not configured
+func (c *FeeBudgetConfig) GetDailyLimit() *big.Int {
+	if c.DailyLimit == "" {
+		return new(big.Int)
+	} else if limit, err := utils.ParseEtherAmount(c.DailyLimit); err != nil {
+		panic(err)
+	} else {
+		return limit
+	}
+}
+
+func (c *TopUpConfig) ValidateBasic() error {
+	if c.TreasurySigner == nil {
+		return fmt.Errorf("config attribute \"treasury_signer\" is empty")
+	} else if err := c.TreasurySigner.GetCachedValue().(signer.SignerConfig).Validate(); err != nil {
+		return fmt.Errorf("config attribute \"treasury_signer\" is invalid: %v", err)
+	}
+	low, err := utils.ParseEtherAmount(c.LowWatermark)
+	if err != nil {
+		return fmt.Errorf("config attribute \"low_watermark\" is invalid: %v", err)
+	}
+	high, err := utils.ParseEtherAmount(c.HighWatermark)
+	if err != nil {
+		return fmt.Errorf("config attribute \"high_watermark\" is invalid: %v", err)
+	}
+	if low.Cmp(high) >= 0 {
+		return fmt.Errorf("config attribute \"low_watermark\" must be less than \"high_watermark\"")
+	}
+	return nil
+}
+
+// CONTRACT: c.ValidateBasic() must be called before calling this method.
+func (c *TopUpConfig) GetLowWatermark() *big.Int {
+	low, err := utils.ParseEtherAmount(c.LowWatermark)
+	if err != nil {
+		panic(err)
+	}
+	return low
+}
+
+// CONTRACT: c.ValidateBasic() must be called before calling this method.
+func (c *TopUpConfig) GetHighWatermark() *big.Int {
+	high, err := utils.ParseEtherAmount(c.HighWatermark)
+	if err != nil {
+		panic(err)
+	}
+	return high
+}
+
+func (c *TxPolicyConfig) ValidateBasic() error {
+	for i, addr := range c.AllowedToAddresses {
+		if !common.IsHexAddress(addr) {
+			return fmt.Errorf("config attribute \"allowed_to_addresses[%d]\" should be hex address", i)
+		}
+	}
+	for i, sel := range c.AllowedSelectors {
+		if _, err := parseSelector(sel); err != nil {
+			return fmt.Errorf("config attribute \"allowed_selectors[%d]\" is invalid: %v", i, err)
+		}
+	}
+	for name, amount := range map[string]string{
+		"max_value":     c.MaxValue,
+		"max_gas_price": c.MaxGasPrice,
+		"max_fee":       c.MaxFee,
+	} {
+		if amount == "" {
+			continue
+		}
+		if _, err := utils.ParseEtherAmount(amount); err != nil {
+			return fmt.Errorf("config attribute \"%s\" is invalid: %v", name, err)
+		}
+	}
+	return nil
+}
@@ -38,20 +38,55 @@ type ChainConfig struct {
 	// option for ibc-solidity ADR-001
 	// if set, the relayer updates a LC contract directly if possible
 	// if null, the relayer updates a LC contract via the handler
-	AllowLcFunctions    *AllowLCFunctionsConfig `protobuf:"bytes,11,opt,name=allow_lc_functions,json=allowLcFunctions,proto3" json:"allow_lc_functions,omitempty"`
-	GasEstimateRate     *Fraction               `protobuf:"bytes,12,opt,name=gas_estimate_rate,json=gasEstimateRate,proto3" json:"gas_estimate_rate,omitempty"`
-	MaxGasLimit         uint64                  `protobuf:"varint,13,opt,name=max_gas_limit,json=maxGasLimit,proto3" json:"max_gas_limit,omitempty"`
-	TxType              string                  `protobuf:"bytes,14,opt,name=tx_type,json=txType,proto3" json:"tx_type,omitempty"`
-	DynamicTxGasConfig  *DynamicTxGasConfig     `protobuf:"bytes,15,opt,name=dynamic_tx_gas_config,json=dynamicTxGasConfig,proto3" json:"dynamic_tx_gas_config,omitempty"`
-	BlocksPerEventQuery uint64                  `protobuf:"varint,16,opt,name=blocks_per_event_query,json=blocksPerEventQuery,proto3" json:"blocks_per_event_query,omitempty"`
-	AbiPaths            []string                `protobuf:"bytes,17,rep,name=abi_paths,json=abiPaths,proto3" json:"abi_paths,omitempty"`
-	Multicall3Address   string                  `protobuf:"bytes,18,opt,name=multicall3_address,json=multicall3Address,proto3" json:"multicall3_address,omitempty"`
+	AllowLcFunctions *AllowLCFunctionsConfig `protobuf:"bytes,11,opt,name=allow_lc_functions,json=allowLcFunctions,proto3" json:"allow_lc_functions,omitempty"`
+	GasEstimateRate  *Fraction               `protobuf:"bytes,12,opt,name=gas_estimate_rate,json=gasEstimateRate,proto3" json:"gas_estimate_rate,omitempty"`
+	MaxGasLimit      uint64                  `protobuf:"varint,13,opt,name=max_gas_limit,json=maxGasLimit,proto3" json:"max_gas_limit,omitempty"`
+	// Type of txs ("legacy", "access_list", "dynamic" or "auto").
+	// "access_list" txs are priced in the same way as "legacy" ones and carry access lists generated by eth_createAccessList.
+	TxType              string              `protobuf:"bytes,14,opt,name=tx_type,json=txType,proto3" json:"tx_type,omitempty"`
+	DynamicTxGasConfig  *DynamicTxGasConfig `protobuf:"bytes,15,opt,name=dynamic_tx_gas_config,json=dynamicTxGasConfig,proto3" json:"dynamic_tx_gas_config,omitempty"`
+	BlocksPerEventQuery uint64              `protobuf:"varint,16,opt,name=blocks_per_event_query,json=blocksPerEventQuery,proto3" json:"blocks_per_event_query,omitempty"`
+	AbiPaths            []string            `protobuf:"bytes,17,rep,name=abi_paths,json=abiPaths,proto3" json:"abi_paths,omitempty"`
+	Multicall3Address   string              `protobuf:"bytes,18,opt,name=multicall3_address,json=multicall3Address,proto3" json:"multicall3_address,omitempty"`
 	// Types that are valid to be assigned to XTxMaxSize:
 	//	*ChainConfig_TxMaxSize
 	XTxMaxSize isChainConfig_XTxMaxSize `protobuf_oneof:"_tx_max_size"`
 	PriceBump  uint64                   `protobuf:"varint,20,opt,name=price_bump,json=priceBump,proto3" json:"price_bump,omitempty"`
 	// Gas cap for eth_estimateGas RPC call.
 	EstimateGasCap uint64 `protobuf:"varint,21,opt,name=estimate_gas_cap,json=estimateGasCap,proto3" json:"estimate_gas_cap,omitempty"`
+	// Denom of the native token balance returned by QueryBalance (wei, gwei or ether).
+	// If empty, wei is used.
+	BalanceDenom string `protobuf:"bytes,22,opt,name=balance_denom,json=balanceDenom,proto3" json:"balance_denom,omitempty"`
+	// Addresses of ERC-20 tokens whose balances are returned by QueryBalance
+	Erc20TokenAddresses []string `protobuf:"bytes,23,rep,name=erc20_token_addresses,json=erc20TokenAddresses,proto3" json:"erc20_token_addresses,omitempty"`
+	// Block height from which QueryDenomTraces scans packet events (e.g. the height at which the ICS-20 app was deployed)
+	DenomTraceStartHeight uint64 `protobuf:"varint,24,opt,name=denom_trace_start_height,json=denomTraceStartHeight,proto3" json:"denom_trace_start_height,omitempty"`
+	// Minimum balance of the relayer account (e.g. "100000000gwei").
+	// If the balance is below this, SendMsgs refuses to send msgs. If empty, the balance is not checked.
+	MinBalance string `protobuf:"bytes,25,opt,name=min_balance,json=minBalance,proto3" json:"min_balance,omitempty"`
+	// Budget for tx fees paid by the relayer account. If nil, the fees are not limited.
+	FeeBudget *FeeBudgetConfig `protobuf:"bytes,26,opt,name=fee_budget,json=feeBudget,proto3" json:"fee_budget,omitempty"`
+	// Automatic top-up of the relayer account from a treasury account. If nil, the relayer account is never topped up.
+	TopUp *TopUpConfig `protobuf:"bytes,27,opt,name=top_up,json=topUp,proto3" json:"top_up,omitempty"`
+	// Policy that every tx must satisfy to be signed by the relayer accounts. If nil, txs are not checked.
+	TxPolicy *TxPolicyConfig `protobuf:"bytes,28,opt,name=tx_policy,json=txPolicy,proto3" json:"tx_policy,omitempty"`
+	// Source of the gas fee suggestions ("node", "fee_history" or "gas_station").
+	// If empty, "fee_history" is used for tx_type "dynamic" and "node" is used otherwise.
+	GasOracle string `protobuf:"bytes,29,opt,name=gas_oracle,json=gasOracle,proto3" json:"gas_oracle,omitempty"`
+	// Gas station API used if gas_oracle is "gas_station"
+	GasStation *GasStationConfig `protobuf:"bytes,30,opt,name=gas_station,json=gasStation,proto3" json:"gas_station,omitempty"`
+	// Fee model of the L2 chain ("op_stack" or "arbitrum") used to estimate the L1 data fee of txs.
+	// If empty, the L1 data fee is not estimated.
+	L2FeeModel string `protobuf:"bytes,31,opt,name=l2_fee_model,json=l2FeeModel,proto3" json:"l2_fee_model,omitempty"`
+	// Maximum L1 data fee of a tx (e.g. "1000000gwei"). Multicall batches are shrunk to stay within this.
+	// If empty, the L1 data fee is not limited.
+	MaxL1Fee string `protobuf:"bytes,32,opt,name=max_l1_fee,json=maxL1Fee,proto3" json:"max_l1_fee,omitempty"`
+	// If true, access lists are generated by eth_createAccessList for dynamic fee txs and attached
+	// to the txs if they reduce the gas. tx_type "access_list" enables this for txs priced by gas price.
+	CreateAccessList bool `protobuf:"varint,33,opt,name=create_access_list,json=createAccessList,proto3" json:"create_access_list,omitempty"`
+	// Bounds of the gas price of legacy txs, which apply to tx_type "legacy", "access_list" and "auto" on chains
+	// without EIP-1559. If nil, the suggested gas price is used as is.
+	LegacyTxGasConfig *LegacyTxGasConfig `protobuf:"bytes,34,opt,name=legacy_tx_gas_config,json=legacyTxGasConfig,proto3" json:"legacy_tx_gas_config,omitempty"`
 }
 
 func (m *ChainConfig) Reset()         { *m = ChainConfig{} }
@@ -197,6 +232,392 @@ func (m *Fraction) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Fraction proto.InternalMessageInfo
 
+type FeeBudgetConfig struct {
+	// Maximum amount of fees paid in the last hour (e.g. "10000000gwei"). If empty, the fees are not limited hourly.
+	HourlyLimit string `protobuf:"bytes,1,opt,name=hourly_limit,json=hourlyLimit,proto3" json:"hourly_limit,omitempty"`
+	// Maximum amount of fees paid in the last day (e.g. "100000000gwei"). If empty, the fees are not limited daily.
+	DailyLimit string `protobuf:"bytes,2,opt,name=daily_limit,json=dailyLimit,proto3" json:"daily_limit,omitempty"`
+}
+
+func (m *FeeBudgetConfig) Reset()         { *m = FeeBudgetConfig{} }
+func (m *FeeBudgetConfig) String() string { return proto.CompactTextString(m) }
+func (*FeeBudgetConfig) ProtoMessage()    {}
+func (*FeeBudgetConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a8a57ab2f9f14837, []int{3}
+}
+func (m *FeeBudgetConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeBudgetConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeBudgetConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeBudgetConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeBudgetConfig.Merge(m, src)
+}
+func (m *FeeBudgetConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeBudgetConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeBudgetConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeBudgetConfig proto.InternalMessageInfo
+
+// SignerPoolConfig is a signer config that holds multiple relayer accounts.
+// If `signer` in ChainConfig is a pool, txs are dispatched across the accounts in round-robin.
+// A MsgUpdateClient and the following msgs up to the next MsgUpdateClient are sent from one account.
+type SignerPoolConfig struct {
+	Signers []*types.Any `protobuf:"bytes,1,rep,name=signers,proto3" json:"signers,omitempty"`
+}
+
+func (m *SignerPoolConfig) Reset()         { *m = SignerPoolConfig{} }
+func (m *SignerPoolConfig) String() string { return proto.CompactTextString(m) }
+func (*SignerPoolConfig) ProtoMessage()    {}
+func (*SignerPoolConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a8a57ab2f9f14837, []int{4}
+}
+func (m *SignerPoolConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SignerPoolConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SignerPoolConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SignerPoolConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignerPoolConfig.Merge(m, src)
+}
+func (m *SignerPoolConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *SignerPoolConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignerPoolConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignerPoolConfig proto.InternalMessageInfo
+
+// KeystoreSignerConfig is a signer config that loads a private key from a geth keystore (V3 JSON) file
+type KeystoreSignerConfig struct {
+	// Path of the keystore file
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	// Path of a file that contains the password of the keystore
+	PasswordFile string `protobuf:"bytes,2,opt,name=password_file,json=passwordFile,proto3" json:"password_file,omitempty"`
+	// Name of an environment variable that contains the password of the keystore
+	PasswordEnv string `protobuf:"bytes,3,opt,name=password_env,json=passwordEnv,proto3" json:"password_env,omitempty"`
+}
+
+func (m *KeystoreSignerConfig) Reset()         { *m = KeystoreSignerConfig{} }
+func (m *KeystoreSignerConfig) String() string { return proto.CompactTextString(m) }
+func (*KeystoreSignerConfig) ProtoMessage()    {}
+func (*KeystoreSignerConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a8a57ab2f9f14837, []int{5}
+}
+func (m *KeystoreSignerConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *KeystoreSignerConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_KeystoreSignerConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *KeystoreSignerConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KeystoreSignerConfig.Merge(m, src)
+}
+func (m *KeystoreSignerConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *KeystoreSignerConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_KeystoreSignerConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_KeystoreSignerConfig proto.InternalMessageInfo
+
+// RemoteSignerConfig is a signer config for a signing service (e.g. Web3Signer or Clef)
+// that signs txs via `eth_signTransaction` JSON-RPC
+type RemoteSignerConfig struct {
+	// JSON-RPC endpoint of the signing service
+	RpcAddr string `protobuf:"bytes,1,opt,name=rpc_addr,json=rpcAddr,proto3" json:"rpc_addr,omitempty"`
+	// Address of the account managed by the signing service
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *RemoteSignerConfig) Reset()         { *m = RemoteSignerConfig{} }
+func (m *RemoteSignerConfig) String() string { return proto.CompactTextString(m) }
+func (*RemoteSignerConfig) ProtoMessage()    {}
+func (*RemoteSignerConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a8a57ab2f9f14837, []int{6}
+}
+func (m *RemoteSignerConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RemoteSignerConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RemoteSignerConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RemoteSignerConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoteSignerConfig.Merge(m, src)
+}
+func (m *RemoteSignerConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *RemoteSignerConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoteSignerConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemoteSignerConfig proto.InternalMessageInfo
+
+type TopUpConfig struct {
+	// Signer of the treasury account that funds the relayer account
+	TreasurySigner *types.Any `protobuf:"bytes,1,opt,name=treasury_signer,json=treasurySigner,proto3" json:"treasury_signer,omitempty"`
+	// The relayer account is topped up if its balance falls below this (e.g. "100000000gwei")
+	LowWatermark string `protobuf:"bytes,2,opt,name=low_watermark,json=lowWatermark,proto3" json:"low_watermark,omitempty"`
+	// The relayer account is topped up to this balance (e.g. "1000000000gwei")
+	HighWatermark string `protobuf:"bytes,3,opt,name=high_watermark,json=highWatermark,proto3" json:"high_watermark,omitempty"`
+	// Minimum interval between top-ups in seconds
+	MinIntervalSec uint64 `protobuf:"varint,4,opt,name=min_interval_sec,json=minIntervalSec,proto3" json:"min_interval_sec,omitempty"`
+}
+
+func (m *TopUpConfig) Reset()         { *m = TopUpConfig{} }
+func (m *TopUpConfig) String() string { return proto.CompactTextString(m) }
+func (*TopUpConfig) ProtoMessage()    {}
+func (*TopUpConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a8a57ab2f9f14837, []int{7}
+}
+func (m *TopUpConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TopUpConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TopUpConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TopUpConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TopUpConfig.Merge(m, src)
+}
+func (m *TopUpConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *TopUpConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_TopUpConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TopUpConfig proto.InternalMessageInfo
+
+type TxPolicyConfig struct {
+	// Destination addresses allowed in addition to the IBC handler (only for the methods that relay msgs), multicall3 and the LC in `allow_lc_functions`
+	AllowedToAddresses []string `protobuf:"bytes,1,rep,name=allowed_to_addresses,json=allowedToAddresses,proto3" json:"allowed_to_addresses,omitempty"`
+	// Function selectors (e.g. "0x12345678") allowed for `allowed_to_addresses`. If empty, any function is allowed.
+	AllowedSelectors []string `protobuf:"bytes,2,rep,name=allowed_selectors,json=allowedSelectors,proto3" json:"allowed_selectors,omitempty"`
+	// Maximum value transferred by a tx (e.g. "1gwei"). If empty, txs must not transfer any value.
+	MaxValue string `protobuf:"bytes,3,opt,name=max_value,json=maxValue,proto3" json:"max_value,omitempty"`
+	// Maximum gas price of legacy txs and gas fee cap of dynamic fee txs (e.g. "100gwei"). If empty, it is not limited.
+	MaxGasPrice string `protobuf:"bytes,4,opt,name=max_gas_price,json=maxGasPrice,proto3" json:"max_gas_price,omitempty"`
+	// Maximum fee of a tx, which is gas limit multiplied by the gas price or the gas fee cap (e.g. "10000000gwei").
+	// If empty, it is not limited.
+	MaxFee string `protobuf:"bytes,5,opt,name=max_fee,json=maxFee,proto3" json:"max_fee,omitempty"`
+}
+
+func (m *TxPolicyConfig) Reset()         { *m = TxPolicyConfig{} }
+func (m *TxPolicyConfig) String() string { return proto.CompactTextString(m) }
+func (*TxPolicyConfig) ProtoMessage()    {}
+func (*TxPolicyConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a8a57ab2f9f14837, []int{8}
+}
+func (m *TxPolicyConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TxPolicyConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TxPolicyConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TxPolicyConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxPolicyConfig.Merge(m, src)
+}
+func (m *TxPolicyConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *TxPolicyConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxPolicyConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TxPolicyConfig proto.InternalMessageInfo
+
+type GasStationConfig struct {
+	// URL of the gas station API that returns fee suggestions in gwei, e.g.
+	// {"fast": {"maxPriorityFee": 30.5, "maxFee": 31.2}, "estimatedBaseFee": 0.7, "blockNumber": 100}
+	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	// Key of the speed tier used in the response (e.g. "fast")
+	Speed string `protobuf:"bytes,2,opt,name=speed,proto3" json:"speed,omitempty"`
+	// Maximum number of blocks by which the response may lag behind the latest block. If zero, 3 is used.
+	MaxBlockLag uint64 `protobuf:"varint,3,opt,name=max_block_lag,json=maxBlockLag,proto3" json:"max_block_lag,omitempty"`
+	// Timeout of the API request in milliseconds. If zero, 3000 is used.
+	TimeoutMsec uint64 `protobuf:"varint,4,opt,name=timeout_msec,json=timeoutMsec,proto3" json:"timeout_msec,omitempty"`
+}
+
+func (m *GasStationConfig) Reset()         { *m = GasStationConfig{} }
+func (m *GasStationConfig) String() string { return proto.CompactTextString(m) }
+func (*GasStationConfig) ProtoMessage()    {}
+func (*GasStationConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a8a57ab2f9f14837, []int{9}
+}
+func (m *GasStationConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GasStationConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GasStationConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GasStationConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GasStationConfig.Merge(m, src)
+}
+func (m *GasStationConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *GasStationConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_GasStationConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GasStationConfig proto.InternalMessageInfo
+
+type LegacyTxGasConfig struct {
+	// Rate multiplied to the suggested gas price
+	GasPriceRate *Fraction `protobuf:"bytes,1,opt,name=gas_price_rate,json=gasPriceRate,proto3" json:"gas_price_rate,omitempty"`
+	// Maximum gas price (e.g. "100gwei"). If empty, the gas price is not limited.
+	LimitGasPrice string `protobuf:"bytes,2,opt,name=limit_gas_price,json=limitGasPrice,proto3" json:"limit_gas_price,omitempty"`
+	// Minimum gas price (e.g. "1gwei"). If empty, the gas price has no floor.
+	MinGasPrice string `protobuf:"bytes,3,opt,name=min_gas_price,json=minGasPrice,proto3" json:"min_gas_price,omitempty"`
+}
+
+func (m *LegacyTxGasConfig) Reset()         { *m = LegacyTxGasConfig{} }
+func (m *LegacyTxGasConfig) String() string { return proto.CompactTextString(m) }
+func (*LegacyTxGasConfig) ProtoMessage()    {}
+func (*LegacyTxGasConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a8a57ab2f9f14837, []int{10}
+}
+func (m *LegacyTxGasConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LegacyTxGasConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LegacyTxGasConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LegacyTxGasConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LegacyTxGasConfig.Merge(m, src)
+}
+func (m *LegacyTxGasConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *LegacyTxGasConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_LegacyTxGasConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LegacyTxGasConfig proto.InternalMessageInfo
+
+// FeeUrgencyTier raises the fees of txs that carry packets close to their timeouts
+type FeeUrgencyTier struct {
+	// The tier applies to txs with a MsgRecvPacket whose packet times out within this number of blocks
+	MaxBlocksToTimeout uint64 `protobuf:"varint,1,opt,name=max_blocks_to_timeout,json=maxBlocksToTimeout,proto3" json:"max_blocks_to_timeout,omitempty"`
+	// Multiplier applied on top of priority_fee_rate. If nil, priority_fee_rate is used as is.
+	PriorityFeeMultiplier *Fraction `protobuf:"bytes,2,opt,name=priority_fee_multiplier,json=priorityFeeMultiplier,proto3" json:"priority_fee_multiplier,omitempty"`
+	// Multiplier applied on top of base_fee_rate. If nil, base_fee_rate is used as is.
+	BaseFeeMultiplier *Fraction `protobuf:"bytes,3,opt,name=base_fee_multiplier,json=baseFeeMultiplier,proto3" json:"base_fee_multiplier,omitempty"`
+}
+
+func (m *FeeUrgencyTier) Reset()         { *m = FeeUrgencyTier{} }
+func (m *FeeUrgencyTier) String() string { return proto.CompactTextString(m) }
+func (*FeeUrgencyTier) ProtoMessage()    {}
+func (*FeeUrgencyTier) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a8a57ab2f9f14837, []int{11}
+}
+func (m *FeeUrgencyTier) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeUrgencyTier) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeUrgencyTier.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeUrgencyTier) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeUrgencyTier.Merge(m, src)
+}
+func (m *FeeUrgencyTier) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeUrgencyTier) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeUrgencyTier.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeUrgencyTier proto.InternalMessageInfo
+
 type DynamicTxGasConfig struct {
 	LimitPriorityFeePerGas     string    `protobuf:"bytes,1,opt,name=limit_priority_fee_per_gas,json=limitPriorityFeePerGas,proto3" json:"limit_priority_fee_per_gas,omitempty"`
 	PriorityFeeRate            *Fraction `protobuf:"bytes,2,opt,name=priority_fee_rate,json=priorityFeeRate,proto3" json:"priority_fee_rate,omitempty"`
@@ -204,13 +625,22 @@ type DynamicTxGasConfig struct {
 	BaseFeeRate                *Fraction `protobuf:"bytes,4,opt,name=base_fee_rate,json=baseFeeRate,proto3" json:"base_fee_rate,omitempty"`
 	FeeHistoryRewardPercentile uint32    `protobuf:"varint,5,opt,name=fee_history_reward_percentile,json=feeHistoryRewardPercentile,proto3" json:"fee_history_reward_percentile,omitempty"`
 	MaxRetryForFeeHistory      uint32    `protobuf:"varint,6,opt,name=max_retry_for_fee_history,json=maxRetryForFeeHistory,proto3" json:"max_retry_for_fee_history,omitempty"`
+	// Number of blocks whose rewards at fee_history_reward_percentile are aggregated into the priority fee by their median.
+	// The base fee projected for the next block is used in this case.
+	// If zero or one, the reward in the latest block with non-zero rewards is used with the base fee of the block.
+	FeeHistoryBlockCount uint32 `protobuf:"varint,7,opt,name=fee_history_block_count,json=feeHistoryBlockCount,proto3" json:"fee_history_block_count,omitempty"`
+	// Tiers that scale the fees by the number of blocks remaining before the earliest packet timeout in a tx.
+	// The tier with the smallest max_blocks_to_timeout that covers the tx is used, and the fees are still capped
+	// by limit_priority_fee_per_gas and limit_fee_per_gas. The remaining blocks until a timeout timestamp are
+	// estimated with average_block_time_msec.
+	UrgencyTiers []*FeeUrgencyTier `protobuf:"bytes,8,rep,name=urgency_tiers,json=urgencyTiers,proto3" json:"urgency_tiers,omitempty"`
 }
 
 func (m *DynamicTxGasConfig) Reset()         { *m = DynamicTxGasConfig{} }
 func (m *DynamicTxGasConfig) String() string { return proto.CompactTextString(m) }
 func (*DynamicTxGasConfig) ProtoMessage()    {}
 func (*DynamicTxGasConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a8a57ab2f9f14837, []int{3}
+	return fileDescriptor_a8a57ab2f9f14837, []int{12}
 }
 func (m *DynamicTxGasConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -243,6 +673,15 @@ func init() {
 	proto.RegisterType((*ChainConfig)(nil), "relayer.chains.ethereum.config.ChainConfig")
 	proto.RegisterType((*AllowLCFunctionsConfig)(nil), "relayer.chains.ethereum.config.AllowLCFunctionsConfig")
 	proto.RegisterType((*Fraction)(nil), "relayer.chains.ethereum.config.Fraction")
+	proto.RegisterType((*FeeBudgetConfig)(nil), "relayer.chains.ethereum.config.FeeBudgetConfig")
+	proto.RegisterType((*SignerPoolConfig)(nil), "relayer.chains.ethereum.config.SignerPoolConfig")
+	proto.RegisterType((*KeystoreSignerConfig)(nil), "relayer.chains.ethereum.config.KeystoreSignerConfig")
+	proto.RegisterType((*RemoteSignerConfig)(nil), "relayer.chains.ethereum.config.RemoteSignerConfig")
+	proto.RegisterType((*TopUpConfig)(nil), "relayer.chains.ethereum.config.TopUpConfig")
+	proto.RegisterType((*TxPolicyConfig)(nil), "relayer.chains.ethereum.config.TxPolicyConfig")
+	proto.RegisterType((*GasStationConfig)(nil), "relayer.chains.ethereum.config.GasStationConfig")
+	proto.RegisterType((*LegacyTxGasConfig)(nil), "relayer.chains.ethereum.config.LegacyTxGasConfig")
+	proto.RegisterType((*FeeUrgencyTier)(nil), "relayer.chains.ethereum.config.FeeUrgencyTier")
 	proto.RegisterType((*DynamicTxGasConfig)(nil), "relayer.chains.ethereum.config.DynamicTxGasConfig")
 }
 
@@ -251,68 +690,121 @@ func init() {
 }
 
 var fileDescriptor_a8a57ab2f9f14837 = []byte{
-	// 976 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0xcd, 0x6e, 0x1b, 0x37,
-	0x10, 0xb6, 0x62, 0xd7, 0x91, 0x28, 0xdb, 0xb1, 0x19, 0xff, 0xac, 0xdd, 0x46, 0x15, 0xdc, 0x8b,
-	0x8a, 0xc6, 0x12, 0x10, 0xa3, 0x41, 0xd1, 0x9b, 0xad, 0xc4, 0x49, 0x0a, 0x07, 0x70, 0x37, 0x3e,
-	0xf5, 0x42, 0x70, 0xb9, 0xa3, 0x15, 0x61, 0x2e, 0xb9, 0x25, 0xb9, 0x8e, 0x94, 0x27, 0xe8, 0xb1,
-	0x0f, 0x50, 0xa0, 0xaf, 0x93, 0x63, 0x8e, 0x3d, 0xb6, 0xf6, 0x8b, 0x14, 0x9c, 0xd5, 0x9f, 0x91,
-	0xa2, 0x41, 0x4e, 0x12, 0xe7, 0xfb, 0xe6, 0x9b, 0x19, 0x72, 0x66, 0x96, 0x7c, 0x67, 0x41, 0xf1,
-	0x31, 0xd8, 0x9e, 0x18, 0x72, 0xa9, 0x5d, 0x0f, 0xfc, 0x10, 0x2c, 0x94, 0x79, 0x4f, 0x18, 0x3d,
-	0x90, 0xd9, 0xe4, 0xa7, 0x5b, 0x58, 0xe3, 0x0d, 0x6d, 0x4d, 0xc8, 0xdd, 0x8a, 0xdc, 0x9d, 0x92,
-	0xbb, 0x15, 0xeb, 0x60, 0x3b, 0x33, 0x99, 0x41, 0x6a, 0x2f, 0xfc, 0xab, 0xbc, 0x0e, 0xf6, 0x33,
-	0x63, 0x32, 0x05, 0x3d, 0x3c, 0x25, 0xe5, 0xa0, 0xc7, 0xf5, 0xb8, 0x82, 0x0e, 0xff, 0xac, 0x93,
-	0x66, 0x3f, 0x68, 0xf5, 0x51, 0x80, 0xee, 0x93, 0x3a, 0x4a, 0x33, 0x99, 0x46, 0xb5, 0x76, 0xad,
-	0xd3, 0x88, 0xef, 0xe3, 0xf9, 0x55, 0x4a, 0xdb, 0x64, 0x0d, 0xfc, 0x90, 0xcd, 0xe0, 0x7b, 0xed,
-	0x5a, 0x67, 0x25, 0x26, 0xe0, 0x87, 0xfd, 0x09, 0x63, 0x9f, 0xd4, 0x6d, 0x21, 0x18, 0x4f, 0x53,
-	0x1b, 0x2d, 0x57, 0xce, 0xb6, 0x10, 0x27, 0x69, 0x6a, 0xe9, 0x63, 0xb2, 0xea, 0x64, 0xa6, 0xc1,
-	0x46, 0x2b, 0xed, 0x5a, 0xa7, 0xf9, 0x64, 0xbb, 0x5b, 0xe5, 0xd4, 0x9d, 0xe6, 0xd4, 0x3d, 0xd1,
-	0xe3, 0x78, 0xc2, 0xa1, 0x5f, 0x93, 0xa6, 0x4c, 0x2a, 0x21, 0x70, 0x2e, 0xfa, 0x02, 0xb5, 0x88,
-	0x4c, 0x50, 0x0b, 0x9c, 0xa3, 0x4f, 0xc9, 0x9e, 0xd4, 0xd2, 0x4b, 0xae, 0x98, 0x03, 0x9d, 0x32,
-	0x31, 0x04, 0x71, 0x55, 0x18, 0xa9, 0x7d, 0xb4, 0x8a, 0x69, 0xed, 0x4c, 0xe0, 0x37, 0xa0, 0xd3,
-	0xfe, 0x0c, 0x5c, 0xf4, 0xb3, 0x20, 0xae, 0x17, 0xfd, 0xee, 0xdf, 0xf1, 0x8b, 0x41, 0x5c, 0x2f,
-	0xf8, 0x3d, 0x26, 0x14, 0x34, 0x4f, 0x14, 0xb0, 0x14, 0x92, 0x32, 0x63, 0xde, 0x72, 0x01, 0x51,
-	0xbd, 0x5d, 0xeb, 0xd4, 0xe3, 0xcd, 0x0a, 0x79, 0x16, 0x80, 0xcb, 0x60, 0xa7, 0xdf, 0x93, 0x3d,
-	0x7e, 0x0d, 0x96, 0x67, 0xc0, 0x12, 0x65, 0xc4, 0x15, 0xf3, 0x32, 0x07, 0x96, 0x3b, 0x10, 0x51,
-	0x03, 0xa3, 0x6c, 0x4f, 0xe0, 0xd3, 0x80, 0x5e, 0xca, 0x1c, 0x5e, 0x3b, 0x10, 0xc1, 0x2d, 0xe7,
-	0x23, 0x66, 0xc1, 0xdb, 0x31, 0x1b, 0x18, 0xcb, 0xa4, 0x16, 0xaa, 0x74, 0xd2, 0xe8, 0x88, 0x54,
-	0x6e, 0x39, 0x1f, 0xc5, 0x01, 0x3d, 0x33, 0xf6, 0xd5, 0x14, 0xa3, 0x29, 0xa1, 0x5c, 0x29, 0xf3,
-	0x96, 0x29, 0xc1, 0x06, 0xa5, 0x16, 0x5e, 0x1a, 0xed, 0xa2, 0x26, 0x5e, 0xf3, 0xd3, 0xee, 0xff,
-	0x37, 0x4c, 0xf7, 0x24, 0x78, 0x9e, 0xf7, 0xcf, 0xa6, 0x7e, 0x55, 0x1b, 0xc4, 0x9b, 0xa8, 0x78,
-	0x2e, 0x66, 0x76, 0x7a, 0x49, 0xb6, 0x32, 0xee, 0x18, 0x38, 0x2f, 0x73, 0xee, 0x81, 0x59, 0xee,
-	0x21, 0x5a, 0xc3, 0x20, 0x9d, 0x4f, 0x05, 0x39, 0xb3, 0x1c, 0x55, 0xe2, 0x07, 0x19, 0x77, 0xcf,
-	0x27, 0x0a, 0x31, 0xf7, 0x40, 0x0f, 0xc9, 0x7a, 0x28, 0x39, 0x28, 0x2b, 0x99, 0x4b, 0x1f, 0xad,
-	0x63, 0xa1, 0xcd, 0x9c, 0x8f, 0x5e, 0x70, 0x77, 0x1e, 0x4c, 0x74, 0x8f, 0xdc, 0xf7, 0x23, 0xe6,
-	0xc7, 0x05, 0x44, 0x1b, 0xd8, 0x08, 0xab, 0x7e, 0x74, 0x39, 0x2e, 0x80, 0x02, 0xd9, 0x49, 0xc7,
-	0x9a, 0xe7, 0x52, 0x30, 0x5f, 0x69, 0x54, 0xf1, 0xa2, 0x07, 0x98, 0xd6, 0x93, 0x4f, 0xa5, 0xf5,
-	0xac, 0x72, 0xbe, 0x0c, 0xa1, 0x26, 0x75, 0xd3, 0xf4, 0x23, 0x1b, 0x3d, 0x26, 0xbb, 0xf8, 0x8a,
-	0x8e, 0x15, 0x60, 0x19, 0x5c, 0x83, 0xf6, 0xec, 0xd7, 0x12, 0xec, 0x38, 0xda, 0xc4, 0x64, 0x1f,
-	0x56, 0xe8, 0x05, 0xd8, 0xe7, 0x01, 0xfb, 0x39, 0x40, 0xf4, 0x4b, 0xd2, 0xe0, 0x89, 0x64, 0x05,
-	0xf7, 0x43, 0x17, 0x6d, 0xb5, 0x97, 0x3b, 0x8d, 0xb8, 0xce, 0x13, 0x79, 0x11, 0xce, 0xf4, 0x88,
-	0xd0, 0xbc, 0x54, 0x5e, 0x0a, 0xae, 0xd4, 0xf1, 0xac, 0xcb, 0x29, 0x16, 0xb7, 0x35, 0x47, 0xa6,
-	0xcd, 0xfe, 0x0d, 0x69, 0xfa, 0x11, 0x0b, 0xf7, 0xe4, 0xe4, 0x3b, 0x88, 0x1e, 0x86, 0xa8, 0x2f,
-	0x97, 0xe2, 0x86, 0x1f, 0xbd, 0xe6, 0xa3, 0x37, 0xf2, 0x1d, 0xfc, 0x56, 0xab, 0xd1, 0x47, 0x84,
-	0x14, 0x56, 0x0a, 0x60, 0x49, 0x99, 0x17, 0xd1, 0x36, 0x66, 0xd6, 0x40, 0xcb, 0x69, 0x99, 0x17,
-	0xb4, 0x43, 0x36, 0x67, 0x4f, 0x87, 0x37, 0xc5, 0x8b, 0x68, 0x07, 0x49, 0x1b, 0x53, 0x7b, 0xa8,
-	0x98, 0x17, 0xa7, 0x1b, 0x64, 0x8d, 0x2d, 0x84, 0x3b, 0xb4, 0x64, 0xf7, 0xbf, 0x9b, 0x24, 0x84,
-	0x54, 0xf3, 0x21, 0xad, 0xb6, 0x45, 0x43, 0xcd, 0x66, 0x34, 0x5c, 0x01, 0xf6, 0x25, 0x57, 0x0a,
-	0x97, 0x45, 0x3d, 0xae, 0xa3, 0xe1, 0x44, 0x29, 0xfa, 0x15, 0x69, 0x38, 0x50, 0x20, 0xbc, 0xb1,
-	0x2e, 0x5a, 0xc6, 0xfb, 0x99, 0x1b, 0x0e, 0x7f, 0x22, 0xf5, 0x69, 0xcf, 0x04, 0xa6, 0x2e, 0x73,
-	0xb0, 0xdc, 0x1b, 0x8b, 0x41, 0x56, 0xe2, 0xb9, 0x81, 0xb6, 0x49, 0x33, 0x05, 0x6d, 0x72, 0xa9,
-	0x11, 0xaf, 0x76, 0xd2, 0xa2, 0xe9, 0xf0, 0x8f, 0x65, 0x42, 0x3f, 0x7e, 0x69, 0xfa, 0x23, 0x39,
-	0xc0, 0x8e, 0x63, 0x85, 0x95, 0xc6, 0x4a, 0x3f, 0x66, 0x03, 0x00, 0x7c, 0xe1, 0x8c, 0x4f, 0x8b,
-	0xd9, 0x45, 0xc6, 0xc5, 0x84, 0x70, 0x06, 0x70, 0x01, 0xf6, 0x05, 0xc7, 0x59, 0xb8, 0xe3, 0x85,
-	0xb3, 0x70, 0xef, 0x73, 0x67, 0xa1, 0x98, 0xeb, 0xe2, 0x2c, 0x7c, 0x4b, 0xb6, 0xaa, 0x8c, 0x16,
-	0x13, 0xa9, 0xd6, 0xe8, 0x06, 0x02, 0xf3, 0x04, 0xce, 0xc9, 0x7a, 0xc2, 0x1d, 0xcc, 0x83, 0xaf,
-	0x7c, 0x66, 0xf0, 0x66, 0x70, 0x9f, 0x06, 0x3e, 0x21, 0x8f, 0x82, 0xd0, 0x50, 0x3a, 0x6f, 0xec,
-	0x98, 0x59, 0x78, 0xcb, 0x6d, 0x1a, 0x32, 0x10, 0xa0, 0xbd, 0x54, 0x80, 0xfb, 0x77, 0x3d, 0x3e,
-	0x18, 0x00, 0xbc, 0xac, 0x38, 0x31, 0x52, 0x2e, 0x66, 0x0c, 0xfa, 0x03, 0xd9, 0xbf, 0xbb, 0xba,
-	0x16, 0x04, 0x71, 0x23, 0xaf, 0xc7, 0x3b, 0x0b, 0xcb, 0xeb, 0x6c, 0xa6, 0x74, 0xca, 0xdf, 0xff,
-	0xd3, 0x5a, 0x7a, 0x7f, 0xd3, 0xaa, 0x7d, 0xb8, 0x69, 0xd5, 0xfe, 0xbe, 0x69, 0xd5, 0x7e, 0xbf,
-	0x6d, 0x2d, 0x7d, 0xb8, 0x6d, 0x2d, 0xfd, 0x75, 0xdb, 0x5a, 0xfa, 0xa5, 0x9f, 0x49, 0x3f, 0x2c,
-	0x93, 0xae, 0x30, 0x79, 0x2f, 0xe5, 0x9e, 0x63, 0x5d, 0x8a, 0x27, 0xb3, 0xaf, 0xe4, 0x91, 0x4c,
-	0xc4, 0x11, 0x56, 0x7d, 0x84, 0x58, 0xaf, 0xb8, 0xca, 0x7a, 0x78, 0x9e, 0x51, 0x92, 0x55, 0xfc,
-	0xc6, 0x1c, 0xff, 0x1b, 0x00, 0x00, 0xff, 0xff, 0xda, 0xe8, 0xfc, 0x9f, 0x6a, 0x07, 0x00, 0x00,
+	// 1815 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x57, 0x4f, 0x73, 0x1b, 0x49,
+	0x15, 0xcf, 0xac, 0x1d, 0x5b, 0x6a, 0x59, 0xb2, 0xdd, 0xb1, 0xe3, 0xb1, 0x77, 0xe3, 0x78, 0xb5,
+	0x05, 0x65, 0x2a, 0x1b, 0x29, 0x71, 0x8a, 0x85, 0xa2, 0x8a, 0x83, 0xed, 0xc4, 0x49, 0x58, 0x67,
+	0xf1, 0x8e, 0x15, 0xa0, 0xb8, 0x34, 0xad, 0x99, 0xe7, 0x51, 0x97, 0x7b, 0xfe, 0xd0, 0xdd, 0x63,
+	0x4b, 0x7b, 0xe3, 0xc6, 0x91, 0x8f, 0xc1, 0x8d, 0xef, 0xc0, 0x69, 0xb9, 0xed, 0x91, 0x23, 0x24,
+	0x1f, 0x82, 0x23, 0x54, 0xbf, 0x9e, 0x19, 0xc9, 0x0e, 0xac, 0xf1, 0x49, 0xea, 0xf7, 0x7b, 0xff,
+	0xfb, 0xbd, 0xd7, 0x6f, 0xc8, 0x23, 0x05, 0x92, 0x4f, 0x40, 0xf5, 0xc3, 0x11, 0x17, 0xa9, 0xee,
+	0x83, 0x19, 0x81, 0x82, 0x22, 0xe9, 0x87, 0x59, 0x7a, 0x26, 0xe2, 0xf2, 0xa7, 0x97, 0xab, 0xcc,
+	0x64, 0x74, 0xbb, 0x64, 0xee, 0x39, 0xe6, 0x5e, 0xc5, 0xdc, 0x73, 0x5c, 0x5b, 0x6b, 0x71, 0x16,
+	0x67, 0xc8, 0xda, 0xb7, 0xff, 0x9c, 0xd4, 0xd6, 0x66, 0x9c, 0x65, 0xb1, 0x84, 0x3e, 0x9e, 0x86,
+	0xc5, 0x59, 0x9f, 0xa7, 0x13, 0x07, 0x75, 0xff, 0xd5, 0x26, 0xad, 0x43, 0xab, 0xeb, 0x10, 0x15,
+	0xd0, 0x4d, 0xd2, 0x40, 0xd5, 0x4c, 0x44, 0xbe, 0xb7, 0xe3, 0xed, 0x36, 0x83, 0x45, 0x3c, 0xbf,
+	0x8e, 0xe8, 0x0e, 0x59, 0x02, 0x33, 0x62, 0x35, 0xfc, 0xd1, 0x8e, 0xb7, 0x3b, 0x1f, 0x10, 0x30,
+	0xa3, 0xc3, 0x92, 0x63, 0x93, 0x34, 0x54, 0x1e, 0x32, 0x1e, 0x45, 0xca, 0x9f, 0x73, 0xc2, 0x2a,
+	0x0f, 0xf7, 0xa3, 0x48, 0xd1, 0xcf, 0xc9, 0x82, 0x16, 0x71, 0x0a, 0xca, 0x9f, 0xdf, 0xf1, 0x76,
+	0x5b, 0x7b, 0x6b, 0x3d, 0xe7, 0x53, 0xaf, 0xf2, 0xa9, 0xb7, 0x9f, 0x4e, 0x82, 0x92, 0x87, 0x3e,
+	0x24, 0x2d, 0x31, 0x74, 0x8a, 0x40, 0x6b, 0xff, 0x2e, 0xea, 0x22, 0x62, 0x88, 0xba, 0x40, 0x6b,
+	0xfa, 0x05, 0xd9, 0x10, 0xa9, 0x30, 0x82, 0x4b, 0xa6, 0x21, 0x8d, 0x58, 0x38, 0x82, 0xf0, 0x3c,
+	0xcf, 0x44, 0x6a, 0xfc, 0x05, 0x74, 0x6b, 0xbd, 0x84, 0x4f, 0x21, 0x8d, 0x0e, 0x6b, 0x70, 0x56,
+	0x4e, 0x41, 0x78, 0x31, 0x2b, 0xb7, 0x78, 0x45, 0x2e, 0x80, 0xf0, 0x62, 0x46, 0xee, 0x73, 0x42,
+	0x21, 0xe5, 0x43, 0x09, 0x2c, 0x82, 0x61, 0x11, 0x33, 0xa3, 0x78, 0x08, 0x7e, 0x63, 0xc7, 0xdb,
+	0x6d, 0x04, 0x2b, 0x0e, 0x79, 0x6e, 0x81, 0x81, 0xa5, 0xd3, 0x1f, 0x93, 0x0d, 0x7e, 0x01, 0x8a,
+	0xc7, 0xc0, 0x86, 0x32, 0x0b, 0xcf, 0x99, 0x11, 0x09, 0xb0, 0x44, 0x43, 0xe8, 0x37, 0xd1, 0xca,
+	0x5a, 0x09, 0x1f, 0x58, 0x74, 0x20, 0x12, 0x78, 0xa3, 0x21, 0xb4, 0x62, 0x09, 0x1f, 0x33, 0x05,
+	0x46, 0x4d, 0xd8, 0x59, 0xa6, 0x98, 0x48, 0x43, 0x59, 0x68, 0x91, 0xa5, 0x3e, 0x71, 0x62, 0x09,
+	0x1f, 0x07, 0x16, 0x3d, 0xca, 0xd4, 0xeb, 0x0a, 0xa3, 0x11, 0xa1, 0x5c, 0xca, 0xec, 0x92, 0xc9,
+	0x90, 0x9d, 0x15, 0x69, 0x68, 0x44, 0x96, 0x6a, 0xbf, 0x85, 0x69, 0xfe, 0xa2, 0xf7, 0xfd, 0x05,
+	0xd3, 0xdb, 0xb7, 0x92, 0xc7, 0x87, 0x47, 0x95, 0x9c, 0x2b, 0x83, 0x60, 0x05, 0x35, 0x1e, 0x87,
+	0x35, 0x9d, 0x0e, 0xc8, 0x6a, 0xcc, 0x35, 0x03, 0x6d, 0x44, 0xc2, 0x0d, 0x30, 0xc5, 0x0d, 0xf8,
+	0x4b, 0x68, 0x64, 0xf7, 0x26, 0x23, 0x47, 0x8a, 0xa3, 0x96, 0x60, 0x39, 0xe6, 0xfa, 0x45, 0xa9,
+	0x21, 0xe0, 0x06, 0x68, 0x97, 0xb4, 0x6d, 0xc8, 0x56, 0xb3, 0x14, 0x89, 0x30, 0x7e, 0x1b, 0x03,
+	0x6d, 0x25, 0x7c, 0xfc, 0x92, 0xeb, 0x63, 0x4b, 0xa2, 0x1b, 0x64, 0xd1, 0x8c, 0x99, 0x99, 0xe4,
+	0xe0, 0x77, 0xb0, 0x10, 0x16, 0xcc, 0x78, 0x30, 0xc9, 0x81, 0x02, 0x59, 0x8f, 0x26, 0x29, 0x4f,
+	0x44, 0xc8, 0x8c, 0xd3, 0xe1, 0xec, 0xf9, 0xcb, 0xe8, 0xd6, 0xde, 0x4d, 0x6e, 0x3d, 0x77, 0xc2,
+	0x03, 0x6b, 0xaa, 0x8c, 0x9b, 0x46, 0x1f, 0xd0, 0xe8, 0x33, 0x72, 0x1f, 0x6f, 0x51, 0xb3, 0x1c,
+	0x14, 0x83, 0x0b, 0x48, 0x0d, 0xfb, 0x7d, 0x01, 0x6a, 0xe2, 0xaf, 0xa0, 0xb3, 0xf7, 0x1c, 0x7a,
+	0x02, 0xea, 0x85, 0xc5, 0xbe, 0xb6, 0x10, 0xfd, 0x98, 0x34, 0xf9, 0x50, 0xb0, 0x9c, 0x9b, 0x91,
+	0xf6, 0x57, 0x77, 0xe6, 0x76, 0x9b, 0x41, 0x83, 0x0f, 0xc5, 0x89, 0x3d, 0xd3, 0xc7, 0x84, 0x26,
+	0x85, 0x34, 0x22, 0xe4, 0x52, 0x3e, 0xab, 0xab, 0x9c, 0x62, 0x70, 0xab, 0x53, 0xa4, 0x2a, 0xf6,
+	0xcf, 0x48, 0xcb, 0x8c, 0x99, 0xcd, 0x93, 0x16, 0xdf, 0x80, 0x7f, 0xcf, 0x5a, 0x7d, 0x75, 0x27,
+	0x68, 0x9a, 0xf1, 0x1b, 0x3e, 0x3e, 0x15, 0xdf, 0xc0, 0x1f, 0x3d, 0x8f, 0x3e, 0x20, 0x24, 0x57,
+	0x22, 0x04, 0x36, 0x2c, 0x92, 0xdc, 0x5f, 0x43, 0xcf, 0x9a, 0x48, 0x39, 0x28, 0x92, 0x9c, 0xee,
+	0x92, 0x95, 0xfa, 0xea, 0x30, 0x53, 0x3c, 0xf7, 0xd7, 0x91, 0xa9, 0x53, 0xd1, 0x6d, 0xc4, 0x3c,
+	0xa7, 0x9f, 0x91, 0xf6, 0x90, 0x4b, 0x9e, 0x86, 0xb6, 0xd6, 0xd3, 0x2c, 0xf1, 0xef, 0xa3, 0x5f,
+	0x4b, 0x25, 0xf1, 0xb9, 0xa5, 0xd1, 0x3d, 0xb2, 0x0e, 0x2a, 0xdc, 0x7b, 0xc2, 0x4c, 0x76, 0x0e,
+	0x69, 0x15, 0x02, 0x68, 0x7f, 0x03, 0x43, 0xbd, 0x87, 0xe0, 0xc0, 0x62, 0xfb, 0x15, 0x44, 0x7f,
+	0x42, 0x7c, 0x54, 0xe8, 0x9a, 0x87, 0x69, 0xc3, 0x95, 0x61, 0x23, 0x10, 0xf1, 0xc8, 0xf8, 0xbe,
+	0x6b, 0x3e, 0xc4, 0xb1, 0x87, 0x4e, 0x2d, 0xfa, 0x0a, 0x41, 0x3b, 0x0d, 0x12, 0x91, 0xb2, 0xd2,
+	0x01, 0x7f, 0xd3, 0x4d, 0x83, 0x44, 0xa4, 0x07, 0x8e, 0x42, 0xbf, 0x22, 0xe4, 0x0c, 0x6c, 0xe4,
+	0x51, 0x0c, 0xc6, 0xdf, 0xc2, 0xdb, 0xef, 0xdf, 0x58, 0x94, 0x00, 0x07, 0x28, 0x50, 0x5e, 0x7d,
+	0xf3, 0xac, 0x22, 0xd0, 0x03, 0xb2, 0x60, 0xb2, 0x9c, 0x15, 0xb9, 0xff, 0x31, 0xea, 0x7a, 0x74,
+	0x93, 0xae, 0x41, 0x96, 0xbf, 0xcd, 0x4b, 0x3d, 0x77, 0x8d, 0x3d, 0xd0, 0x2f, 0x49, 0xd3, 0x8c,
+	0x59, 0x9e, 0x49, 0x11, 0x4e, 0xfc, 0x4f, 0x50, 0x4d, 0xef, 0x46, 0x35, 0xe3, 0x13, 0xe4, 0x2f,
+	0x35, 0x35, 0x4c, 0x79, 0xb6, 0x97, 0x6b, 0x2f, 0x2d, 0x53, 0x3c, 0x94, 0xe0, 0x3f, 0xc0, 0x04,
+	0x34, 0x63, 0xae, 0x7f, 0x89, 0x04, 0xfa, 0x35, 0x69, 0x59, 0x58, 0x1b, 0x6e, 0xbb, 0xcc, 0xdf,
+	0x46, 0x6b, 0x4f, 0x6e, 0xb2, 0xf6, 0x92, 0xeb, 0x53, 0x27, 0x51, 0xda, 0x23, 0x71, 0x4d, 0xb1,
+	0xc3, 0x5e, 0xee, 0x31, 0x9b, 0xd5, 0x24, 0x8b, 0x40, 0xfa, 0x0f, 0x5d, 0xd2, 0xe5, 0xde, 0x11,
+	0xc0, 0x1b, 0x4b, 0xa1, 0x9f, 0x10, 0x62, 0x4b, 0x52, 0x3e, 0xb5, 0x5c, 0xfe, 0x0e, 0xe2, 0x8d,
+	0x84, 0x8f, 0x8f, 0x9f, 0x1e, 0x01, 0xd8, 0x81, 0x19, 0x2a, 0xb0, 0xd5, 0xc6, 0xc3, 0x10, 0xb4,
+	0x6d, 0x6f, 0x6d, 0xfc, 0x4f, 0xdd, 0xc0, 0x74, 0xc8, 0x3e, 0x02, 0xc7, 0x42, 0x1b, 0x3a, 0x24,
+	0x6b, 0x12, 0x62, 0x1e, 0x4e, 0xae, 0x35, 0x72, 0x17, 0x23, 0x79, 0x7a, 0x53, 0x24, 0xc7, 0x28,
+	0x3b, 0xdb, 0xc7, 0xab, 0xf2, 0x3a, 0xe9, 0xa0, 0x43, 0x96, 0xd8, 0x4c, 0x1b, 0x75, 0x15, 0xb9,
+	0xff, 0xdf, 0x87, 0x9f, 0xcd, 0xb6, 0x9c, 0x3e, 0x3e, 0xee, 0x15, 0x6c, 0xca, 0xfa, 0xed, 0xb1,
+	0xad, 0x6d, 0x05, 0x19, 0x97, 0x12, 0x1f, 0xc1, 0x46, 0xd0, 0x40, 0xc2, 0xbe, 0xb4, 0x59, 0x69,
+	0x6a, 0x90, 0x10, 0x9a, 0x4c, 0x69, 0x7f, 0x0e, 0x9b, 0x61, 0x4a, 0xe8, 0xfe, 0x82, 0x34, 0xaa,
+	0x59, 0x68, 0x39, 0xd3, 0x22, 0x01, 0xc5, 0x4d, 0xa6, 0xd0, 0xc8, 0x7c, 0x30, 0x25, 0xd0, 0x1d,
+	0xd2, 0xc2, 0x66, 0x10, 0x29, 0xe2, 0xee, 0xad, 0x9d, 0x25, 0x75, 0xdf, 0x92, 0xe5, 0x6b, 0x25,
+	0x4c, 0x3f, 0x25, 0x4b, 0xa3, 0xac, 0x50, 0x72, 0x52, 0x0e, 0x53, 0xe7, 0x7a, 0xcb, 0xd1, 0xdc,
+	0x30, 0x7d, 0x48, 0x5a, 0x11, 0x17, 0x35, 0xc7, 0x47, 0xee, 0x5a, 0x91, 0x84, 0x0c, 0xdd, 0x03,
+	0xb2, 0x72, 0x8a, 0x8f, 0xf0, 0x49, 0x96, 0xc9, 0x52, 0x6f, 0x8f, 0x2c, 0xba, 0x87, 0xd9, 0x66,
+	0x63, 0xee, 0x7f, 0xbe, 0xde, 0x15, 0x53, 0x57, 0x91, 0xb5, 0x2f, 0x61, 0xa2, 0x4d, 0xa6, 0xc0,
+	0xe9, 0x2a, 0xf5, 0x50, 0x32, 0x6f, 0x07, 0x62, 0xe9, 0x17, 0xfe, 0xb7, 0xe3, 0x26, 0xe7, 0x5a,
+	0x5f, 0x66, 0x2a, 0x62, 0x67, 0x42, 0x42, 0xe9, 0xd2, 0x52, 0x45, 0x3c, 0x12, 0x12, 0x6c, 0x60,
+	0x35, 0x13, 0xa4, 0x17, 0xe5, 0x72, 0xd1, 0xaa, 0x68, 0x2f, 0xd2, 0x8b, 0xee, 0x6b, 0x42, 0x03,
+	0x48, 0x32, 0x73, 0xd5, 0xe2, 0xec, 0x46, 0xe2, 0x5d, 0xdd, 0x48, 0x7c, 0xb2, 0x58, 0x5d, 0xb1,
+	0x33, 0x59, 0x1d, 0xbb, 0x7f, 0xf5, 0x48, 0x6b, 0xa6, 0xa3, 0xe9, 0xcf, 0xc9, 0xb2, 0x51, 0xc0,
+	0x75, 0xa1, 0x26, 0xac, 0x5c, 0x62, 0xbc, 0xef, 0x59, 0x62, 0x3a, 0x15, 0xb3, 0xf3, 0xc4, 0x46,
+	0x68, 0xab, 0xe5, 0x92, 0x1b, 0x50, 0x09, 0x57, 0xe7, 0x55, 0x84, 0x32, 0xbb, 0xfc, 0x75, 0x45,
+	0xa3, 0x3f, 0x20, 0x9d, 0x91, 0x88, 0x47, 0x33, 0x5c, 0x2e, 0xc6, 0xb6, 0xa5, 0x4e, 0xd9, 0x76,
+	0xc9, 0x8a, 0x1d, 0x85, 0x22, 0x35, 0xa0, 0x2e, 0x70, 0xf9, 0x09, 0x71, 0xa1, 0x9a, 0x0f, 0x3a,
+	0x89, 0x48, 0x5f, 0x97, 0xe4, 0x53, 0x08, 0xbb, 0x7f, 0xf3, 0x48, 0xe7, 0xea, 0x3c, 0xa1, 0x4f,
+	0xc8, 0x1a, 0xd6, 0x29, 0x44, 0xcc, 0x64, 0x33, 0x33, 0xdb, 0xc3, 0x32, 0xa5, 0x25, 0x36, 0xc8,
+	0xa6, 0x23, 0xfb, 0x11, 0x59, 0xad, 0x24, 0xa6, 0x55, 0xfd, 0x11, 0xb2, 0xaf, 0x94, 0xc0, 0x69,
+	0x45, 0xb7, 0x7d, 0x61, 0x9b, 0xeb, 0x82, 0xcb, 0x02, 0xfc, 0xb9, 0x7a, 0x1e, 0xfc, 0xca, 0x9e,
+	0x67, 0x1f, 0x7a, 0x7c, 0x94, 0xd0, 0xeb, 0x66, 0xf5, 0xd0, 0x9f, 0x58, 0x92, 0x7d, 0xe8, 0x2d,
+	0x8f, 0x1d, 0x27, 0x6e, 0xe3, 0x5b, 0x48, 0xf8, 0xf8, 0x08, 0xa0, 0xfb, 0x07, 0x8f, 0xac, 0x5c,
+	0x9f, 0x56, 0x74, 0x85, 0xcc, 0x15, 0x4a, 0x96, 0xb7, 0x6a, 0xff, 0xd2, 0x35, 0x72, 0x57, 0xe7,
+	0x00, 0x51, 0x99, 0x60, 0x77, 0xa8, 0x2c, 0xbb, 0x45, 0x4c, 0xf2, 0xd8, 0x9f, 0xab, 0x57, 0x0c,
+	0x5c, 0xbf, 0x8e, 0x39, 0x36, 0x8e, 0x5d, 0xd1, 0xb2, 0xc2, 0xb8, 0x2d, 0xcd, 0xa5, 0xb4, 0x55,
+	0xd2, 0xec, 0x72, 0xd6, 0xfd, 0x8b, 0x47, 0x56, 0x3f, 0x98, 0x33, 0xf4, 0x2b, 0xd2, 0xa9, 0x43,
+	0x72, 0x2b, 0x91, 0x77, 0xcb, 0x95, 0x68, 0x29, 0x2e, 0xc3, 0xc7, 0x7d, 0xe8, 0x87, 0x64, 0x19,
+	0x1b, 0x73, 0x26, 0x51, 0x2e, 0x98, 0x36, 0x92, 0xeb, 0x54, 0xd9, 0xa0, 0x44, 0x3a, 0xc3, 0x55,
+	0x76, 0x44, 0x22, 0xd2, 0x8a, 0xa7, 0xfb, 0x6f, 0x8f, 0x74, 0x8e, 0x00, 0xde, 0xaa, 0x18, 0xd2,
+	0x70, 0x32, 0x10, 0xa0, 0xe8, 0x53, 0xb2, 0x5e, 0xe7, 0x42, 0xdb, 0x22, 0x28, 0x23, 0x2c, 0xe7,
+	0x0f, 0xad, 0x72, 0xa2, 0x07, 0xd9, 0xc0, 0x21, 0xf4, 0x77, 0x64, 0x23, 0x57, 0x22, 0x53, 0xc2,
+	0x4c, 0xdc, 0x73, 0x60, 0xd7, 0x93, 0x5c, 0x0a, 0x70, 0x43, 0xe9, 0x36, 0xa1, 0xae, 0x57, 0x8a,
+	0xec, 0x1b, 0x52, 0xab, 0xa1, 0xbf, 0x21, 0xf7, 0x86, 0x5c, 0xc3, 0x75, 0xed, 0x73, 0xb7, 0xd4,
+	0xbe, 0x6a, 0x95, 0x5c, 0xd1, 0xdc, 0xfd, 0xf3, 0x3c, 0xa1, 0x1f, 0x2e, 0x79, 0xf4, 0x67, 0x64,
+	0xcb, 0x25, 0xf9, 0x4a, 0x60, 0x76, 0xb9, 0x8b, 0x79, 0x35, 0xef, 0xef, 0x23, 0xc7, 0xc9, 0xd4,
+	0xe1, 0x13, 0x50, 0x2f, 0x39, 0xae, 0xc1, 0x57, 0xa4, 0xf0, 0xce, 0x6f, 0x9b, 0x88, 0xe5, 0x99,
+	0x44, 0xe0, 0xb5, 0xff, 0x88, 0xac, 0x3a, 0x8f, 0x66, 0x1d, 0x71, 0x57, 0xda, 0x41, 0x60, 0xea,
+	0xc0, 0x31, 0x69, 0xd7, 0xd9, 0x42, 0xe3, 0xf3, 0xb7, 0x34, 0xde, 0x2a, 0xf3, 0x84, 0x86, 0xf7,
+	0xc9, 0x03, 0xab, 0x68, 0x24, 0xec, 0xb0, 0x9e, 0x30, 0x05, 0x97, 0x5c, 0x45, 0xd6, 0x83, 0x10,
+	0x52, 0x23, 0xa4, 0x6b, 0xc4, 0x76, 0xb0, 0x75, 0x06, 0xf0, 0xca, 0xf1, 0x04, 0xc8, 0x72, 0x52,
+	0x73, 0xd0, 0x9f, 0x92, 0xcd, 0xab, 0x5f, 0x2d, 0x33, 0x0a, 0xf1, 0x63, 0xac, 0x1d, 0xac, 0xcf,
+	0x7c, 0xb7, 0x1c, 0xd5, 0x9a, 0xec, 0xf7, 0xce, 0xac, 0x71, 0xd7, 0xa1, 0x61, 0x56, 0x94, 0x1f,
+	0x63, 0xed, 0x60, 0x6d, 0x6a, 0x16, 0xcb, 0xf2, 0xd0, 0x62, 0xf4, 0x94, 0xb4, 0x0b, 0x57, 0xd3,
+	0xcc, 0x08, 0xfb, 0x26, 0x35, 0x76, 0xe6, 0xfe, 0x9f, 0xed, 0xea, 0x6a, 0x2f, 0x04, 0x4b, 0xc5,
+	0xf4, 0xa0, 0x0f, 0xf8, 0xb7, 0xff, 0xdc, 0xbe, 0xf3, 0xed, 0xbb, 0x6d, 0xef, 0xbb, 0x77, 0xdb,
+	0xde, 0x3f, 0xde, 0x6d, 0x7b, 0x7f, 0x7a, 0xbf, 0x7d, 0xe7, 0xbb, 0xf7, 0xdb, 0x77, 0xfe, 0xfe,
+	0x7e, 0xfb, 0xce, 0x6f, 0x0f, 0x63, 0x61, 0x46, 0xc5, 0xb0, 0x17, 0x66, 0x49, 0x3f, 0xe2, 0x86,
+	0xa3, 0x05, 0xc9, 0x87, 0xf5, 0xc7, 0xfa, 0x63, 0x31, 0x0c, 0x1f, 0xa3, 0xfd, 0xc7, 0x88, 0xf5,
+	0xf3, 0xf3, 0xb8, 0x8f, 0xe7, 0x9a, 0x65, 0xb8, 0x80, 0xaf, 0xc4, 0xb3, 0xff, 0x0c, 0x00, 0x20,
+	0x9f, 0x54, 0x35, 0xf1, 0x0f, 0x00, 0x00,
 }
 
 func (m *ChainConfig) Marshal() (dAtA []byte, err error) {
@@ -335,59 +827,53 @@ func (m *ChainConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if m.EstimateGasCap != 0 {
-		i = encodeVarintConfig(dAtA, i, uint64(m.EstimateGasCap))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xa8
-	}
-	if m.PriceBump != 0 {
-		i = encodeVarintConfig(dAtA, i, uint64(m.PriceBump))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xa0
-	}
-	if m.XTxMaxSize != nil {
+	if m.LegacyTxGasConfig != nil {
 		{
-			size := m.XTxMaxSize.Size()
-			i -= size
-			if _, err := m.XTxMaxSize.MarshalTo(dAtA[i:]); err != nil {
+			size, err := m.LegacyTxGasConfig.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
 				return 0, err
 			}
+			i -= size
+			i = encodeVarintConfig(dAtA, i, uint64(size))
 		}
-	}
-	if len(m.Multicall3Address) > 0 {
-		i -= len(m.Multicall3Address)
-		copy(dAtA[i:], m.Multicall3Address)
-		i = encodeVarintConfig(dAtA, i, uint64(len(m.Multicall3Address)))
 		i--
-		dAtA[i] = 0x1
+		dAtA[i] = 0x2
 		i--
 		dAtA[i] = 0x92
 	}
-	if len(m.AbiPaths) > 0 {
-		for iNdEx := len(m.AbiPaths) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.AbiPaths[iNdEx])
-			copy(dAtA[i:], m.AbiPaths[iNdEx])
-			i = encodeVarintConfig(dAtA, i, uint64(len(m.AbiPaths[iNdEx])))
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0x8a
+	if m.CreateAccessList {
+		i--
+		if m.CreateAccessList {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x88
 	}
-	if m.BlocksPerEventQuery != 0 {
-		i = encodeVarintConfig(dAtA, i, uint64(m.BlocksPerEventQuery))
+	if len(m.MaxL1Fee) > 0 {
+		i -= len(m.MaxL1Fee)
+		copy(dAtA[i:], m.MaxL1Fee)
+		i = encodeVarintConfig(dAtA, i, uint64(len(m.MaxL1Fee)))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x82
+	}
+	if len(m.L2FeeModel) > 0 {
+		i -= len(m.L2FeeModel)
+		copy(dAtA[i:], m.L2FeeModel)
+		i = encodeVarintConfig(dAtA, i, uint64(len(m.L2FeeModel)))
 		i--
 		dAtA[i] = 0x1
 		i--
-		dAtA[i] = 0x80
+		dAtA[i] = 0xfa
 	}
-	if m.DynamicTxGasConfig != nil {
+	if m.GasStation != nil {
 		{
-			size, err := m.DynamicTxGasConfig.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.GasStation.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -395,23 +881,174 @@ func (m *ChainConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintConfig(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x7a
-	}
-	if len(m.TxType) > 0 {
-		i -= len(m.TxType)
-		copy(dAtA[i:], m.TxType)
-		i = encodeVarintConfig(dAtA, i, uint64(len(m.TxType)))
+		dAtA[i] = 0x1
 		i--
-		dAtA[i] = 0x72
+		dAtA[i] = 0xf2
 	}
-	if m.MaxGasLimit != 0 {
-		i = encodeVarintConfig(dAtA, i, uint64(m.MaxGasLimit))
+	if len(m.GasOracle) > 0 {
+		i -= len(m.GasOracle)
+		copy(dAtA[i:], m.GasOracle)
+		i = encodeVarintConfig(dAtA, i, uint64(len(m.GasOracle)))
 		i--
-		dAtA[i] = 0x68
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xea
 	}
-	if m.GasEstimateRate != nil {
+	if m.TxPolicy != nil {
 		{
-			size, err := m.GasEstimateRate.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.TxPolicy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintConfig(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xe2
+	}
+	if m.TopUp != nil {
+		{
+			size, err := m.TopUp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintConfig(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xda
+	}
+	if m.FeeBudget != nil {
+		{
+			size, err := m.FeeBudget.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintConfig(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd2
+	}
+	if len(m.MinBalance) > 0 {
+		i -= len(m.MinBalance)
+		copy(dAtA[i:], m.MinBalance)
+		i = encodeVarintConfig(dAtA, i, uint64(len(m.MinBalance)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xca
+	}
+	if m.DenomTraceStartHeight != 0 {
+		i = encodeVarintConfig(dAtA, i, uint64(m.DenomTraceStartHeight))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc0
+	}
+	if len(m.Erc20TokenAddresses) > 0 {
+		for iNdEx := len(m.Erc20TokenAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Erc20TokenAddresses[iNdEx])
+			copy(dAtA[i:], m.Erc20TokenAddresses[iNdEx])
+			i = encodeVarintConfig(dAtA, i, uint64(len(m.Erc20TokenAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xba
+		}
+	}
+	if len(m.BalanceDenom) > 0 {
+		i -= len(m.BalanceDenom)
+		copy(dAtA[i:], m.BalanceDenom)
+		i = encodeVarintConfig(dAtA, i, uint64(len(m.BalanceDenom)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb2
+	}
+	if m.EstimateGasCap != 0 {
+		i = encodeVarintConfig(dAtA, i, uint64(m.EstimateGasCap))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
+	}
+	if m.PriceBump != 0 {
+		i = encodeVarintConfig(dAtA, i, uint64(m.PriceBump))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
+	if m.XTxMaxSize != nil {
+		{
+			size := m.XTxMaxSize.Size()
+			i -= size
+			if _, err := m.XTxMaxSize.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	if len(m.Multicall3Address) > 0 {
+		i -= len(m.Multicall3Address)
+		copy(dAtA[i:], m.Multicall3Address)
+		i = encodeVarintConfig(dAtA, i, uint64(len(m.Multicall3Address)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
+	if len(m.AbiPaths) > 0 {
+		for iNdEx := len(m.AbiPaths) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AbiPaths[iNdEx])
+			copy(dAtA[i:], m.AbiPaths[iNdEx])
+			i = encodeVarintConfig(dAtA, i, uint64(len(m.AbiPaths[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if m.BlocksPerEventQuery != 0 {
+		i = encodeVarintConfig(dAtA, i, uint64(m.BlocksPerEventQuery))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.DynamicTxGasConfig != nil {
+		{
+			size, err := m.DynamicTxGasConfig.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintConfig(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x7a
+	}
+	if len(m.TxType) > 0 {
+		i -= len(m.TxType)
+		copy(dAtA[i:], m.TxType)
+		i = encodeVarintConfig(dAtA, i, uint64(len(m.TxType)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if m.MaxGasLimit != 0 {
+		i = encodeVarintConfig(dAtA, i, uint64(m.MaxGasLimit))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.GasEstimateRate != nil {
+		{
+			size, err := m.GasEstimateRate.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -600,7 +1237,7 @@ func (m *Fraction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *DynamicTxGasConfig) Marshal() (dAtA []byte, err error) {
+func (m *FeeBudgetConfig) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -610,246 +1247,2364 @@ func (m *DynamicTxGasConfig) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DynamicTxGasConfig) MarshalTo(dAtA []byte) (int, error) {
+func (m *FeeBudgetConfig) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DynamicTxGasConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *FeeBudgetConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.MaxRetryForFeeHistory != 0 {
-		i = encodeVarintConfig(dAtA, i, uint64(m.MaxRetryForFeeHistory))
+	if len(m.DailyLimit) > 0 {
+		i -= len(m.DailyLimit)
+		copy(dAtA[i:], m.DailyLimit)
+		i = encodeVarintConfig(dAtA, i, uint64(len(m.DailyLimit)))
 		i--
-		dAtA[i] = 0x30
+		dAtA[i] = 0x12
 	}
-	if m.FeeHistoryRewardPercentile != 0 {
-		i = encodeVarintConfig(dAtA, i, uint64(m.FeeHistoryRewardPercentile))
+	if len(m.HourlyLimit) > 0 {
+		i -= len(m.HourlyLimit)
+		copy(dAtA[i:], m.HourlyLimit)
+		i = encodeVarintConfig(dAtA, i, uint64(len(m.HourlyLimit)))
 		i--
-		dAtA[i] = 0x28
+		dAtA[i] = 0xa
 	}
-	if m.BaseFeeRate != nil {
-		{
-			size, err := m.BaseFeeRate.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+	return len(dAtA) - i, nil
+}
+
+func (m *SignerPoolConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SignerPoolConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SignerPoolConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signers) > 0 {
+		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Signers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintConfig(dAtA, i, uint64(size))
 			}
-			i -= size
-			i = encodeVarintConfig(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0xa
 		}
-		i--
-		dAtA[i] = 0x22
 	}
-	if len(m.LimitFeePerGas) > 0 {
-		i -= len(m.LimitFeePerGas)
-		copy(dAtA[i:], m.LimitFeePerGas)
-		i = encodeVarintConfig(dAtA, i, uint64(len(m.LimitFeePerGas)))
+	return len(dAtA) - i, nil
+}
+
+func (m *KeystoreSignerConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *KeystoreSignerConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *KeystoreSignerConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PasswordEnv) > 0 {
+		i -= len(m.PasswordEnv)
+		copy(dAtA[i:], m.PasswordEnv)
+		i = encodeVarintConfig(dAtA, i, uint64(len(m.PasswordEnv)))
 		i--
 		dAtA[i] = 0x1a
 	}
-	if m.PriorityFeeRate != nil {
-		{
-			size, err := m.PriorityFeeRate.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintConfig(dAtA, i, uint64(size))
-		}
+	if len(m.PasswordFile) > 0 {
+		i -= len(m.PasswordFile)
+		copy(dAtA[i:], m.PasswordFile)
+		i = encodeVarintConfig(dAtA, i, uint64(len(m.PasswordFile)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.LimitPriorityFeePerGas) > 0 {
-		i -= len(m.LimitPriorityFeePerGas)
-		copy(dAtA[i:], m.LimitPriorityFeePerGas)
-		i = encodeVarintConfig(dAtA, i, uint64(len(m.LimitPriorityFeePerGas)))
+	if len(m.Path) > 0 {
+		i -= len(m.Path)
+		copy(dAtA[i:], m.Path)
+		i = encodeVarintConfig(dAtA, i, uint64(len(m.Path)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintConfig(dAtA []byte, offset int, v uint64) int {
-	offset -= sovConfig(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *RemoteSignerConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *ChainConfig) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *RemoteSignerConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemoteSignerConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovConfig(uint64(l))
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintConfig(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.EthChainId != 0 {
-		n += 1 + sovConfig(uint64(m.EthChainId))
+	if len(m.RpcAddr) > 0 {
+		i -= len(m.RpcAddr)
+		copy(dAtA[i:], m.RpcAddr)
+		i = encodeVarintConfig(dAtA, i, uint64(len(m.RpcAddr)))
+		i--
+		dAtA[i] = 0xa
 	}
-	l = len(m.RpcAddr)
-	if l > 0 {
-		n += 1 + l + sovConfig(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *TopUpConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.Signer != nil {
-		l = m.Signer.Size()
-		n += 1 + l + sovConfig(uint64(l))
-	}
-	l = len(m.IbcAddress)
-	if l > 0 {
-		n += 1 + l + sovConfig(uint64(l))
-	}
-	if m.InitialSendCheckpoint != 0 {
-		n += 1 + sovConfig(uint64(m.InitialSendCheckpoint))
-	}
-	if m.InitialRecvCheckpoint != 0 {
-		n += 1 + sovConfig(uint64(m.InitialRecvCheckpoint))
-	}
-	if m.EnableDebugTrace {
-		n += 2
+	return dAtA[:n], nil
+}
+
+func (m *TopUpConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TopUpConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MinIntervalSec != 0 {
+		i = encodeVarintConfig(dAtA, i, uint64(m.MinIntervalSec))
+		i--
+		dAtA[i] = 0x20
 	}
-	if m.AverageBlockTimeMsec != 0 {
-		n += 1 + sovConfig(uint64(m.AverageBlockTimeMsec))
+	if len(m.HighWatermark) > 0 {
+		i -= len(m.HighWatermark)
+		copy(dAtA[i:], m.HighWatermark)
+		i = encodeVarintConfig(dAtA, i, uint64(len(m.HighWatermark)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.MaxRetryForInclusion != 0 {
-		n += 1 + sovConfig(uint64(m.MaxRetryForInclusion))
+	if len(m.LowWatermark) > 0 {
+		i -= len(m.LowWatermark)
+		copy(dAtA[i:], m.LowWatermark)
+		i = encodeVarintConfig(dAtA, i, uint64(len(m.LowWatermark)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.AllowLcFunctions != nil {
-		l = m.AllowLcFunctions.Size()
-		n += 1 + l + sovConfig(uint64(l))
+	if m.TreasurySigner != nil {
+		{
+			size, err := m.TreasurySigner.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintConfig(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	if m.GasEstimateRate != nil {
-		l = m.GasEstimateRate.Size()
-		n += 1 + l + sovConfig(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *TxPolicyConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.MaxGasLimit != 0 {
-		n += 1 + sovConfig(uint64(m.MaxGasLimit))
+	return dAtA[:n], nil
+}
+
+func (m *TxPolicyConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TxPolicyConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MaxFee) > 0 {
+		i -= len(m.MaxFee)
+		copy(dAtA[i:], m.MaxFee)
+		i = encodeVarintConfig(dAtA, i, uint64(len(m.MaxFee)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	l = len(m.TxType)
-	if l > 0 {
-		n += 1 + l + sovConfig(uint64(l))
+	if len(m.MaxGasPrice) > 0 {
+		i -= len(m.MaxGasPrice)
+		copy(dAtA[i:], m.MaxGasPrice)
+		i = encodeVarintConfig(dAtA, i, uint64(len(m.MaxGasPrice)))
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.DynamicTxGasConfig != nil {
-		l = m.DynamicTxGasConfig.Size()
-		n += 1 + l + sovConfig(uint64(l))
+	if len(m.MaxValue) > 0 {
+		i -= len(m.MaxValue)
+		copy(dAtA[i:], m.MaxValue)
+		i = encodeVarintConfig(dAtA, i, uint64(len(m.MaxValue)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.BlocksPerEventQuery != 0 {
-		n += 2 + sovConfig(uint64(m.BlocksPerEventQuery))
+	if len(m.AllowedSelectors) > 0 {
+		for iNdEx := len(m.AllowedSelectors) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedSelectors[iNdEx])
+			copy(dAtA[i:], m.AllowedSelectors[iNdEx])
+			i = encodeVarintConfig(dAtA, i, uint64(len(m.AllowedSelectors[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	if len(m.AbiPaths) > 0 {
-		for _, s := range m.AbiPaths {
-			l = len(s)
-			n += 2 + l + sovConfig(uint64(l))
+	if len(m.AllowedToAddresses) > 0 {
+		for iNdEx := len(m.AllowedToAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedToAddresses[iNdEx])
+			copy(dAtA[i:], m.AllowedToAddresses[iNdEx])
+			i = encodeVarintConfig(dAtA, i, uint64(len(m.AllowedToAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	l = len(m.Multicall3Address)
-	if l > 0 {
-		n += 2 + l + sovConfig(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *GasStationConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.XTxMaxSize != nil {
-		n += m.XTxMaxSize.Size()
+	return dAtA[:n], nil
+}
+
+func (m *GasStationConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GasStationConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TimeoutMsec != 0 {
+		i = encodeVarintConfig(dAtA, i, uint64(m.TimeoutMsec))
+		i--
+		dAtA[i] = 0x20
 	}
-	if m.PriceBump != 0 {
-		n += 2 + sovConfig(uint64(m.PriceBump))
+	if m.MaxBlockLag != 0 {
+		i = encodeVarintConfig(dAtA, i, uint64(m.MaxBlockLag))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.EstimateGasCap != 0 {
-		n += 2 + sovConfig(uint64(m.EstimateGasCap))
+	if len(m.Speed) > 0 {
+		i -= len(m.Speed)
+		copy(dAtA[i:], m.Speed)
+		i = encodeVarintConfig(dAtA, i, uint64(len(m.Speed)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Url) > 0 {
+		i -= len(m.Url)
+		copy(dAtA[i:], m.Url)
+		i = encodeVarintConfig(dAtA, i, uint64(len(m.Url)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *ChainConfig_TxMaxSize) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *LegacyTxGasConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	n += 2 + sovConfig(uint64(m.TxMaxSize))
-	return n
+	return dAtA[:n], nil
 }
-func (m *AllowLCFunctionsConfig) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *LegacyTxGasConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LegacyTxGasConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.LcAddress)
-	if l > 0 {
-		n += 1 + l + sovConfig(uint64(l))
+	if len(m.MinGasPrice) > 0 {
+		i -= len(m.MinGasPrice)
+		copy(dAtA[i:], m.MinGasPrice)
+		i = encodeVarintConfig(dAtA, i, uint64(len(m.MinGasPrice)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.AllowAll {
-		n += 2
+	if len(m.LimitGasPrice) > 0 {
+		i -= len(m.LimitGasPrice)
+		copy(dAtA[i:], m.LimitGasPrice)
+		i = encodeVarintConfig(dAtA, i, uint64(len(m.LimitGasPrice)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.Selectors) > 0 {
-		for _, s := range m.Selectors {
-			l = len(s)
-			n += 1 + l + sovConfig(uint64(l))
+	if m.GasPriceRate != nil {
+		{
+			size, err := m.GasPriceRate.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintConfig(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Fraction) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *FeeUrgencyTier) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeUrgencyTier) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeUrgencyTier) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Numerator != 0 {
-		n += 1 + sovConfig(uint64(m.Numerator))
+	if m.BaseFeeMultiplier != nil {
+		{
+			size, err := m.BaseFeeMultiplier.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintConfig(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.Denominator != 0 {
-		n += 1 + sovConfig(uint64(m.Denominator))
+	if m.PriorityFeeMultiplier != nil {
+		{
+			size, err := m.PriorityFeeMultiplier.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintConfig(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if m.MaxBlocksToTimeout != 0 {
+		i = encodeVarintConfig(dAtA, i, uint64(m.MaxBlocksToTimeout))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *DynamicTxGasConfig) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *DynamicTxGasConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *DynamicTxGasConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DynamicTxGasConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.LimitPriorityFeePerGas)
-	if l > 0 {
-		n += 1 + l + sovConfig(uint64(l))
-	}
-	if m.PriorityFeeRate != nil {
-		l = m.PriorityFeeRate.Size()
-		n += 1 + l + sovConfig(uint64(l))
+	if len(m.UrgencyTiers) > 0 {
+		for iNdEx := len(m.UrgencyTiers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UrgencyTiers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintConfig(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
 	}
-	l = len(m.LimitFeePerGas)
-	if l > 0 {
-		n += 1 + l + sovConfig(uint64(l))
+	if m.FeeHistoryBlockCount != 0 {
+		i = encodeVarintConfig(dAtA, i, uint64(m.FeeHistoryBlockCount))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.MaxRetryForFeeHistory != 0 {
+		i = encodeVarintConfig(dAtA, i, uint64(m.MaxRetryForFeeHistory))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.FeeHistoryRewardPercentile != 0 {
+		i = encodeVarintConfig(dAtA, i, uint64(m.FeeHistoryRewardPercentile))
+		i--
+		dAtA[i] = 0x28
 	}
 	if m.BaseFeeRate != nil {
-		l = m.BaseFeeRate.Size()
+		{
+			size, err := m.BaseFeeRate.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintConfig(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.LimitFeePerGas) > 0 {
+		i -= len(m.LimitFeePerGas)
+		copy(dAtA[i:], m.LimitFeePerGas)
+		i = encodeVarintConfig(dAtA, i, uint64(len(m.LimitFeePerGas)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.PriorityFeeRate != nil {
+		{
+			size, err := m.PriorityFeeRate.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintConfig(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.LimitPriorityFeePerGas) > 0 {
+		i -= len(m.LimitPriorityFeePerGas)
+		copy(dAtA[i:], m.LimitPriorityFeePerGas)
+		i = encodeVarintConfig(dAtA, i, uint64(len(m.LimitPriorityFeePerGas)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintConfig(dAtA []byte, offset int, v uint64) int {
+	offset -= sovConfig(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ChainConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
 		n += 1 + l + sovConfig(uint64(l))
 	}
-	if m.FeeHistoryRewardPercentile != 0 {
-		n += 1 + sovConfig(uint64(m.FeeHistoryRewardPercentile))
+	if m.EthChainId != 0 {
+		n += 1 + sovConfig(uint64(m.EthChainId))
+	}
+	l = len(m.RpcAddr)
+	if l > 0 {
+		n += 1 + l + sovConfig(uint64(l))
+	}
+	if m.Signer != nil {
+		l = m.Signer.Size()
+		n += 1 + l + sovConfig(uint64(l))
+	}
+	l = len(m.IbcAddress)
+	if l > 0 {
+		n += 1 + l + sovConfig(uint64(l))
+	}
+	if m.InitialSendCheckpoint != 0 {
+		n += 1 + sovConfig(uint64(m.InitialSendCheckpoint))
+	}
+	if m.InitialRecvCheckpoint != 0 {
+		n += 1 + sovConfig(uint64(m.InitialRecvCheckpoint))
+	}
+	if m.EnableDebugTrace {
+		n += 2
+	}
+	if m.AverageBlockTimeMsec != 0 {
+		n += 1 + sovConfig(uint64(m.AverageBlockTimeMsec))
+	}
+	if m.MaxRetryForInclusion != 0 {
+		n += 1 + sovConfig(uint64(m.MaxRetryForInclusion))
+	}
+	if m.AllowLcFunctions != nil {
+		l = m.AllowLcFunctions.Size()
+		n += 1 + l + sovConfig(uint64(l))
+	}
+	if m.GasEstimateRate != nil {
+		l = m.GasEstimateRate.Size()
+		n += 1 + l + sovConfig(uint64(l))
+	}
+	if m.MaxGasLimit != 0 {
+		n += 1 + sovConfig(uint64(m.MaxGasLimit))
+	}
+	l = len(m.TxType)
+	if l > 0 {
+		n += 1 + l + sovConfig(uint64(l))
+	}
+	if m.DynamicTxGasConfig != nil {
+		l = m.DynamicTxGasConfig.Size()
+		n += 1 + l + sovConfig(uint64(l))
+	}
+	if m.BlocksPerEventQuery != 0 {
+		n += 2 + sovConfig(uint64(m.BlocksPerEventQuery))
+	}
+	if len(m.AbiPaths) > 0 {
+		for _, s := range m.AbiPaths {
+			l = len(s)
+			n += 2 + l + sovConfig(uint64(l))
+		}
+	}
+	l = len(m.Multicall3Address)
+	if l > 0 {
+		n += 2 + l + sovConfig(uint64(l))
+	}
+	if m.XTxMaxSize != nil {
+		n += m.XTxMaxSize.Size()
+	}
+	if m.PriceBump != 0 {
+		n += 2 + sovConfig(uint64(m.PriceBump))
+	}
+	if m.EstimateGasCap != 0 {
+		n += 2 + sovConfig(uint64(m.EstimateGasCap))
+	}
+	l = len(m.BalanceDenom)
+	if l > 0 {
+		n += 2 + l + sovConfig(uint64(l))
+	}
+	if len(m.Erc20TokenAddresses) > 0 {
+		for _, s := range m.Erc20TokenAddresses {
+			l = len(s)
+			n += 2 + l + sovConfig(uint64(l))
+		}
+	}
+	if m.DenomTraceStartHeight != 0 {
+		n += 2 + sovConfig(uint64(m.DenomTraceStartHeight))
+	}
+	l = len(m.MinBalance)
+	if l > 0 {
+		n += 2 + l + sovConfig(uint64(l))
+	}
+	if m.FeeBudget != nil {
+		l = m.FeeBudget.Size()
+		n += 2 + l + sovConfig(uint64(l))
+	}
+	if m.TopUp != nil {
+		l = m.TopUp.Size()
+		n += 2 + l + sovConfig(uint64(l))
+	}
+	if m.TxPolicy != nil {
+		l = m.TxPolicy.Size()
+		n += 2 + l + sovConfig(uint64(l))
+	}
+	l = len(m.GasOracle)
+	if l > 0 {
+		n += 2 + l + sovConfig(uint64(l))
+	}
+	if m.GasStation != nil {
+		l = m.GasStation.Size()
+		n += 2 + l + sovConfig(uint64(l))
+	}
+	l = len(m.L2FeeModel)
+	if l > 0 {
+		n += 2 + l + sovConfig(uint64(l))
+	}
+	l = len(m.MaxL1Fee)
+	if l > 0 {
+		n += 2 + l + sovConfig(uint64(l))
+	}
+	if m.CreateAccessList {
+		n += 3
+	}
+	if m.LegacyTxGasConfig != nil {
+		l = m.LegacyTxGasConfig.Size()
+		n += 2 + l + sovConfig(uint64(l))
+	}
+	return n
+}
+
+func (m *ChainConfig_TxMaxSize) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 2 + sovConfig(uint64(m.TxMaxSize))
+	return n
+}
+func (m *AllowLCFunctionsConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.LcAddress)
+	if l > 0 {
+		n += 1 + l + sovConfig(uint64(l))
+	}
+	if m.AllowAll {
+		n += 2
+	}
+	if len(m.Selectors) > 0 {
+		for _, s := range m.Selectors {
+			l = len(s)
+			n += 1 + l + sovConfig(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Fraction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Numerator != 0 {
+		n += 1 + sovConfig(uint64(m.Numerator))
+	}
+	if m.Denominator != 0 {
+		n += 1 + sovConfig(uint64(m.Denominator))
+	}
+	return n
+}
+
+func (m *FeeBudgetConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.HourlyLimit)
+	if l > 0 {
+		n += 1 + l + sovConfig(uint64(l))
+	}
+	l = len(m.DailyLimit)
+	if l > 0 {
+		n += 1 + l + sovConfig(uint64(l))
+	}
+	return n
+}
+
+func (m *SignerPoolConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Signers) > 0 {
+		for _, e := range m.Signers {
+			l = e.Size()
+			n += 1 + l + sovConfig(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *KeystoreSignerConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovConfig(uint64(l))
+	}
+	l = len(m.PasswordFile)
+	if l > 0 {
+		n += 1 + l + sovConfig(uint64(l))
+	}
+	l = len(m.PasswordEnv)
+	if l > 0 {
+		n += 1 + l + sovConfig(uint64(l))
+	}
+	return n
+}
+
+func (m *RemoteSignerConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RpcAddr)
+	if l > 0 {
+		n += 1 + l + sovConfig(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovConfig(uint64(l))
+	}
+	return n
+}
+
+func (m *TopUpConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TreasurySigner != nil {
+		l = m.TreasurySigner.Size()
+		n += 1 + l + sovConfig(uint64(l))
+	}
+	l = len(m.LowWatermark)
+	if l > 0 {
+		n += 1 + l + sovConfig(uint64(l))
+	}
+	l = len(m.HighWatermark)
+	if l > 0 {
+		n += 1 + l + sovConfig(uint64(l))
+	}
+	if m.MinIntervalSec != 0 {
+		n += 1 + sovConfig(uint64(m.MinIntervalSec))
+	}
+	return n
+}
+
+func (m *TxPolicyConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AllowedToAddresses) > 0 {
+		for _, s := range m.AllowedToAddresses {
+			l = len(s)
+			n += 1 + l + sovConfig(uint64(l))
+		}
+	}
+	if len(m.AllowedSelectors) > 0 {
+		for _, s := range m.AllowedSelectors {
+			l = len(s)
+			n += 1 + l + sovConfig(uint64(l))
+		}
+	}
+	l = len(m.MaxValue)
+	if l > 0 {
+		n += 1 + l + sovConfig(uint64(l))
+	}
+	l = len(m.MaxGasPrice)
+	if l > 0 {
+		n += 1 + l + sovConfig(uint64(l))
+	}
+	l = len(m.MaxFee)
+	if l > 0 {
+		n += 1 + l + sovConfig(uint64(l))
+	}
+	return n
+}
+
+func (m *GasStationConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Url)
+	if l > 0 {
+		n += 1 + l + sovConfig(uint64(l))
+	}
+	l = len(m.Speed)
+	if l > 0 {
+		n += 1 + l + sovConfig(uint64(l))
+	}
+	if m.MaxBlockLag != 0 {
+		n += 1 + sovConfig(uint64(m.MaxBlockLag))
+	}
+	if m.TimeoutMsec != 0 {
+		n += 1 + sovConfig(uint64(m.TimeoutMsec))
+	}
+	return n
+}
+
+func (m *LegacyTxGasConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GasPriceRate != nil {
+		l = m.GasPriceRate.Size()
+		n += 1 + l + sovConfig(uint64(l))
+	}
+	l = len(m.LimitGasPrice)
+	if l > 0 {
+		n += 1 + l + sovConfig(uint64(l))
+	}
+	l = len(m.MinGasPrice)
+	if l > 0 {
+		n += 1 + l + sovConfig(uint64(l))
+	}
+	return n
+}
+
+func (m *FeeUrgencyTier) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxBlocksToTimeout != 0 {
+		n += 1 + sovConfig(uint64(m.MaxBlocksToTimeout))
+	}
+	if m.PriorityFeeMultiplier != nil {
+		l = m.PriorityFeeMultiplier.Size()
+		n += 1 + l + sovConfig(uint64(l))
+	}
+	if m.BaseFeeMultiplier != nil {
+		l = m.BaseFeeMultiplier.Size()
+		n += 1 + l + sovConfig(uint64(l))
+	}
+	return n
+}
+
+func (m *DynamicTxGasConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.LimitPriorityFeePerGas)
+	if l > 0 {
+		n += 1 + l + sovConfig(uint64(l))
+	}
+	if m.PriorityFeeRate != nil {
+		l = m.PriorityFeeRate.Size()
+		n += 1 + l + sovConfig(uint64(l))
+	}
+	l = len(m.LimitFeePerGas)
+	if l > 0 {
+		n += 1 + l + sovConfig(uint64(l))
+	}
+	if m.BaseFeeRate != nil {
+		l = m.BaseFeeRate.Size()
+		n += 1 + l + sovConfig(uint64(l))
+	}
+	if m.FeeHistoryRewardPercentile != 0 {
+		n += 1 + sovConfig(uint64(m.FeeHistoryRewardPercentile))
+	}
+	if m.MaxRetryForFeeHistory != 0 {
+		n += 1 + sovConfig(uint64(m.MaxRetryForFeeHistory))
+	}
+	if m.FeeHistoryBlockCount != 0 {
+		n += 1 + sovConfig(uint64(m.FeeHistoryBlockCount))
+	}
+	if len(m.UrgencyTiers) > 0 {
+		for _, e := range m.UrgencyTiers {
+			l = e.Size()
+			n += 1 + l + sovConfig(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovConfig(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozConfig(x uint64) (n int) {
+	return sovConfig(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ChainConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowConfig
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChainConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChainConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfig
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConfig
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthChainId", wireType)
+			}
+			m.EthChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EthChainId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RpcAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfig
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConfig
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RpcAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConfig
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthConfig
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Signer == nil {
+				m.Signer = &types.Any{}
+			}
+			if err := m.Signer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IbcAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfig
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConfig
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IbcAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialSendCheckpoint", wireType)
+			}
+			m.InitialSendCheckpoint = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InitialSendCheckpoint |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialRecvCheckpoint", wireType)
+			}
+			m.InitialRecvCheckpoint = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InitialRecvCheckpoint |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableDebugTrace", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EnableDebugTrace = bool(v != 0)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AverageBlockTimeMsec", wireType)
+			}
+			m.AverageBlockTimeMsec = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AverageBlockTimeMsec |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRetryForInclusion", wireType)
+			}
+			m.MaxRetryForInclusion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRetryForInclusion |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowLcFunctions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConfig
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthConfig
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AllowLcFunctions == nil {
+				m.AllowLcFunctions = &AllowLCFunctionsConfig{}
+			}
+			if err := m.AllowLcFunctions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasEstimateRate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConfig
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthConfig
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GasEstimateRate == nil {
+				m.GasEstimateRate = &Fraction{}
+			}
+			if err := m.GasEstimateRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxGasLimit", wireType)
+			}
+			m.MaxGasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxGasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfig
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConfig
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DynamicTxGasConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConfig
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthConfig
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DynamicTxGasConfig == nil {
+				m.DynamicTxGasConfig = &DynamicTxGasConfig{}
+			}
+			if err := m.DynamicTxGasConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlocksPerEventQuery", wireType)
+			}
+			m.BlocksPerEventQuery = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlocksPerEventQuery |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AbiPaths", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfig
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConfig
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AbiPaths = append(m.AbiPaths, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Multicall3Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfig
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConfig
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Multicall3Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxMaxSize", wireType)
+			}
+			var v uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.XTxMaxSize = &ChainConfig_TxMaxSize{v}
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceBump", wireType)
+			}
+			m.PriceBump = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PriceBump |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EstimateGasCap", wireType)
+			}
+			m.EstimateGasCap = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EstimateGasCap |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BalanceDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfig
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConfig
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BalanceDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20TokenAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfig
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConfig
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Erc20TokenAddresses = append(m.Erc20TokenAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 24:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomTraceStartHeight", wireType)
+			}
+			m.DenomTraceStartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DenomTraceStartHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinBalance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfig
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConfig
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinBalance = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 26:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeBudget", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConfig
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthConfig
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FeeBudget == nil {
+				m.FeeBudget = &FeeBudgetConfig{}
+			}
+			if err := m.FeeBudget.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 27:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopUp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConfig
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthConfig
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TopUp == nil {
+				m.TopUp = &TopUpConfig{}
+			}
+			if err := m.TopUp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 28:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConfig
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthConfig
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TxPolicy == nil {
+				m.TxPolicy = &TxPolicyConfig{}
+			}
+			if err := m.TxPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 29:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasOracle", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfig
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConfig
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GasOracle = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 30:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasStation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConfig
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthConfig
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GasStation == nil {
+				m.GasStation = &GasStationConfig{}
+			}
+			if err := m.GasStation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 31:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field L2FeeModel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfig
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConfig
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.L2FeeModel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 32:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxL1Fee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfig
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConfig
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxL1Fee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 33:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateAccessList", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CreateAccessList = bool(v != 0)
+		case 34:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LegacyTxGasConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConfig
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthConfig
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LegacyTxGasConfig == nil {
+				m.LegacyTxGasConfig = &LegacyTxGasConfig{}
+			}
+			if err := m.LegacyTxGasConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipConfig(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthConfig
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AllowLCFunctionsConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowConfig
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AllowLCFunctionsConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AllowLCFunctionsConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LcAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfig
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConfig
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LcAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowAll", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllowAll = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Selectors", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfig
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConfig
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Selectors = append(m.Selectors, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipConfig(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthConfig
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Fraction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowConfig
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Fraction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Fraction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Numerator", wireType)
+			}
+			m.Numerator = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Numerator |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denominator", wireType)
+			}
+			m.Denominator = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Denominator |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipConfig(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthConfig
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeBudgetConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowConfig
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeBudgetConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeBudgetConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HourlyLimit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfig
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConfig
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HourlyLimit = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DailyLimit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfig
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConfig
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DailyLimit = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipConfig(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthConfig
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	if m.MaxRetryForFeeHistory != 0 {
-		n += 1 + sovConfig(uint64(m.MaxRetryForFeeHistory))
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
+func (m *SignerPoolConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowConfig
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignerPoolConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignerPoolConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConfig
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthConfig
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signers = append(m.Signers, &types.Any{})
+			if err := m.Signers[len(m.Signers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipConfig(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthConfig
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovConfig(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozConfig(x uint64) (n int) {
-	return sovConfig(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *ChainConfig) Unmarshal(dAtA []byte) error {
+func (m *KeystoreSignerConfig) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -872,15 +3627,15 @@ func (m *ChainConfig) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ChainConfig: wiretype end group for non-group")
+			return fmt.Errorf("proto: KeystoreSignerConfig: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ChainConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: KeystoreSignerConfig: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -908,13 +3663,13 @@ func (m *ChainConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainId = string(dAtA[iNdEx:postIndex])
+			m.Path = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EthChainId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PasswordFile", wireType)
 			}
-			m.EthChainId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowConfig
@@ -924,12 +3679,107 @@ func (m *ChainConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EthChainId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfig
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConfig
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PasswordFile = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PasswordEnv", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfig
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConfig
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PasswordEnv = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipConfig(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthConfig
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RemoteSignerConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowConfig
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RemoteSignerConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RemoteSignerConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field RpcAddr", wireType)
 			}
@@ -956,14 +3806,96 @@ func (m *ChainConfig) Unmarshal(dAtA []byte) error {
 			if postIndex < 0 {
 				return ErrInvalidLengthConfig
 			}
-			if postIndex > l {
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RpcAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfig
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConfig
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipConfig(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthConfig
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TopUpConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowConfig
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RpcAddr = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TopUpConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TopUpConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TreasurySigner", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -990,16 +3922,16 @@ func (m *ChainConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Signer == nil {
-				m.Signer = &types.Any{}
+			if m.TreasurySigner == nil {
+				m.TreasurySigner = &types.Any{}
 			}
-			if err := m.Signer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TreasurySigner.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IbcAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LowWatermark", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1027,32 +3959,13 @@ func (m *ChainConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IbcAddress = string(dAtA[iNdEx:postIndex])
+			m.LowWatermark = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitialSendCheckpoint", wireType)
-			}
-			m.InitialSendCheckpoint = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowConfig
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.InitialSendCheckpoint |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitialRecvCheckpoint", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HighWatermark", wireType)
 			}
-			m.InitialRecvCheckpoint = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowConfig
@@ -1062,74 +3975,29 @@ func (m *ChainConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.InitialRecvCheckpoint |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EnableDebugTrace", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowConfig
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfig
 			}
-			m.EnableDebugTrace = bool(v != 0)
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AverageBlockTimeMsec", wireType)
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConfig
 			}
-			m.AverageBlockTimeMsec = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowConfig
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.AverageBlockTimeMsec |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-		case 10:
+			m.HighWatermark = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxRetryForInclusion", wireType)
-			}
-			m.MaxRetryForInclusion = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowConfig
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MaxRetryForInclusion |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 11:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllowLcFunctions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinIntervalSec", wireType)
 			}
-			var msglen int
+			m.MinIntervalSec = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowConfig
@@ -1139,33 +4007,66 @@ func (m *ChainConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.MinIntervalSec |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthConfig
+		default:
+			iNdEx = preIndex
+			skippy, err := skipConfig(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthConfig
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AllowLcFunctions == nil {
-				m.AllowLcFunctions = &AllowLCFunctionsConfig{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TxPolicyConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowConfig
 			}
-			if err := m.AllowLcFunctions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 12:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TxPolicyConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TxPolicyConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GasEstimateRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedToAddresses", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowConfig
@@ -1175,50 +4076,27 @@ func (m *ChainConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthConfig
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthConfig
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.GasEstimateRate == nil {
-				m.GasEstimateRate = &Fraction{}
-			}
-			if err := m.GasEstimateRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.AllowedToAddresses = append(m.AllowedToAddresses, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 13:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxGasLimit", wireType)
-			}
-			m.MaxGasLimit = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowConfig
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MaxGasLimit |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 14:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedSelectors", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1246,13 +4124,13 @@ func (m *ChainConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TxType = string(dAtA[iNdEx:postIndex])
+			m.AllowedSelectors = append(m.AllowedSelectors, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 15:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DynamicTxGasConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxValue", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowConfig
@@ -1262,33 +4140,29 @@ func (m *ChainConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthConfig
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthConfig
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.DynamicTxGasConfig == nil {
-				m.DynamicTxGasConfig = &DynamicTxGasConfig{}
-			}
-			if err := m.DynamicTxGasConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxValue = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 16:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlocksPerEventQuery", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxGasPrice", wireType)
 			}
-			m.BlocksPerEventQuery = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowConfig
@@ -1298,14 +4172,27 @@ func (m *ChainConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BlocksPerEventQuery |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 17:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfig
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConfig
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxGasPrice = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AbiPaths", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFee", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1333,11 +4220,61 @@ func (m *ChainConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AbiPaths = append(m.AbiPaths, string(dAtA[iNdEx:postIndex]))
+			m.MaxFee = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 18:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipConfig(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthConfig
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GasStationConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowConfig
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GasStationConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GasStationConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Multicall3Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Url", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1365,13 +4302,13 @@ func (m *ChainConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Multicall3Address = string(dAtA[iNdEx:postIndex])
+			m.Url = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 19:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxMaxSize", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Speed", wireType)
 			}
-			var v uint64
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowConfig
@@ -1381,17 +4318,29 @@ func (m *ChainConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.XTxMaxSize = &ChainConfig_TxMaxSize{v}
-		case 20:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfig
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConfig
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Speed = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PriceBump", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBlockLag", wireType)
 			}
-			m.PriceBump = 0
+			m.MaxBlockLag = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowConfig
@@ -1401,16 +4350,16 @@ func (m *ChainConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PriceBump |= uint64(b&0x7F) << shift
+				m.MaxBlockLag |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 21:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EstimateGasCap", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutMsec", wireType)
 			}
-			m.EstimateGasCap = 0
+			m.TimeoutMsec = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowConfig
@@ -1420,7 +4369,7 @@ func (m *ChainConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EstimateGasCap |= uint64(b&0x7F) << shift
+				m.TimeoutMsec |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1446,7 +4395,7 @@ func (m *ChainConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AllowLCFunctionsConfig) Unmarshal(dAtA []byte) error {
+func (m *LegacyTxGasConfig) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1469,17 +4418,17 @@ func (m *AllowLCFunctionsConfig) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AllowLCFunctionsConfig: wiretype end group for non-group")
+			return fmt.Errorf("proto: LegacyTxGasConfig: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AllowLCFunctionsConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LegacyTxGasConfig: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LcAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPriceRate", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowConfig
@@ -1489,29 +4438,33 @@ func (m *AllowLCFunctionsConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthConfig
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthConfig
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LcAddress = string(dAtA[iNdEx:postIndex])
+			if m.GasPriceRate == nil {
+				m.GasPriceRate = &Fraction{}
+			}
+			if err := m.GasPriceRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllowAll", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LimitGasPrice", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowConfig
@@ -1521,15 +4474,27 @@ func (m *AllowLCFunctionsConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.AllowAll = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfig
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConfig
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LimitGasPrice = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Selectors", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinGasPrice", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1557,7 +4522,7 @@ func (m *AllowLCFunctionsConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Selectors = append(m.Selectors, string(dAtA[iNdEx:postIndex]))
+			m.MinGasPrice = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1580,7 +4545,7 @@ func (m *AllowLCFunctionsConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Fraction) Unmarshal(dAtA []byte) error {
+func (m *FeeUrgencyTier) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1603,17 +4568,17 @@ func (m *Fraction) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Fraction: wiretype end group for non-group")
+			return fmt.Errorf("proto: FeeUrgencyTier: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Fraction: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FeeUrgencyTier: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Numerator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBlocksToTimeout", wireType)
 			}
-			m.Numerator = 0
+			m.MaxBlocksToTimeout = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowConfig
@@ -1623,16 +4588,16 @@ func (m *Fraction) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Numerator |= uint64(b&0x7F) << shift
+				m.MaxBlocksToTimeout |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denominator", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriorityFeeMultiplier", wireType)
 			}
-			m.Denominator = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowConfig
@@ -1642,11 +4607,64 @@ func (m *Fraction) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Denominator |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConfig
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthConfig
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PriorityFeeMultiplier == nil {
+				m.PriorityFeeMultiplier = &Fraction{}
+			}
+			if err := m.PriorityFeeMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFeeMultiplier", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthConfig
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthConfig
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BaseFeeMultiplier == nil {
+				m.BaseFeeMultiplier = &Fraction{}
+			}
+			if err := m.BaseFeeMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipConfig(dAtA[iNdEx:])
@@ -1871,6 +4889,59 @@ func (m *DynamicTxGasConfig) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeHistoryBlockCount", wireType)
+			}
+			m.FeeHistoryBlockCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FeeHistoryBlockCount |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UrgencyTiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConfig
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthConfig
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UrgencyTiers = append(m.UrgencyTiers, &FeeUrgencyTier{})
+			if err := m.UrgencyTiers[len(m.UrgencyTiers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipConfig(dAtA[iNdEx:])
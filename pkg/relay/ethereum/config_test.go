@@ -229,3 +229,29 @@ func TestDynamicTxConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestBalanceDenomUnit(t *testing.T) {
+	var cases = []struct {
+		denom    string
+		expected int64
+		err      bool
+	}{
+		{denom: "", expected: 1},
+		{denom: "wei", expected: 1},
+		{denom: "gwei", expected: 1_000_000_000},
+		{denom: "ether", expected: 1_000_000_000_000_000_000},
+		{denom: "1gwei", err: true},
+		{denom: "finney", err: true},
+	}
+	for _, c := range cases {
+		t.Run(c.denom, func(t *testing.T) {
+			unit, err := ChainConfig{BalanceDenom: c.denom}.BalanceDenomUnit()
+			if c.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, c.expected, unit.Int64())
+		})
+	}
+}
@@ -0,0 +1,125 @@
+package ethereum
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	chantypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	"github.com/hyperledger-labs/yui-relayer/core"
+)
+
+// QueryDenomTraces returns the denom traces of the tokens minted or escrowed by the ICS-20 app bound to the port of the path.
+// The traces are collected by scanning SendPacket and RecvPacket events emitted since `denom_trace_start_height`:
+//   - a voucher is minted when a packet is received with a denom that is not prefixed by the source port and channel
+//   - a native token is escrowed when a packet is sent with a denom that has no trace path
+//
+// The traces are sorted by their full denom paths and paginated by `offset` and `limit` (zero `limit` means no limit).
+func (c *Chain) QueryDenomTraces(ctx core.QueryContext, offset uint64, limit uint64) (*transfertypes.QueryDenomTracesResponse, error) {
+	logger := c.GetChainLogger()
+	if c.pathEnd == nil {
+		return nil, fmt.Errorf("path is not set")
+	}
+
+	recvLogs, err := c.filterLogs(ctx, c.config.DenomTraceStartHeight, abiRecvPacket)
+	if err != nil {
+		logger.ErrorContext(ctx.Context(), "failed to filter RecvPacket logs", err)
+		return nil, err
+	}
+	var recvPackets []chantypes.Packet
+	for _, log := range recvLogs {
+		event, err := c.ibcHandler.ParseRecvPacket(log)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse RecvPacket event: err=%v, log=%v", err, log)
+		}
+		recvPackets = append(recvPackets, chantypes.Packet{
+			SourcePort:         event.Packet.SourcePort,
+			SourceChannel:      event.Packet.SourceChannel,
+			DestinationPort:    event.Packet.DestinationPort,
+			DestinationChannel: event.Packet.DestinationChannel,
+			Data:               event.Packet.Data,
+		})
+	}
+
+	sendLogs, err := c.filterLogs(ctx, c.config.DenomTraceStartHeight, abiSendPacket)
+	if err != nil {
+		logger.ErrorContext(ctx.Context(), "failed to filter SendPacket logs", err)
+		return nil, err
+	}
+	var sentPackets []chantypes.Packet
+	for _, log := range sendLogs {
+		event, err := c.ibcHandler.ParseSendPacket(log)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse SendPacket event: err=%v, log=%v", err, log)
+		}
+		sentPackets = append(sentPackets, chantypes.Packet{
+			SourcePort:    event.SourcePort,
+			SourceChannel: event.SourceChannel,
+			Data:          event.Data,
+		})
+	}
+
+	traces := collectDenomTraces(c.pathEnd.PortID, recvPackets, sentPackets)
+	return &transfertypes.QueryDenomTracesResponse{
+		DenomTraces: paginateDenomTraces(traces, offset, limit),
+		Pagination: &query.PageResponse{
+			Total: uint64(len(traces)),
+		},
+	}, nil
+}
+
+// collectDenomTraces returns the sorted denom traces of the tokens minted or escrowed by the ICS-20 app bound to `portID`.
+// Packets whose data cannot be decoded as FungibleTokenPacketData are ignored.
+func collectDenomTraces(portID string, recvPackets []chantypes.Packet, sentPackets []chantypes.Packet) transfertypes.Traces {
+	seen := make(map[string]bool)
+	var traces transfertypes.Traces
+	add := func(trace transfertypes.DenomTrace) {
+		if path := trace.GetFullDenomPath(); !seen[path] {
+			seen[path] = true
+			traces = append(traces, trace)
+		}
+	}
+
+	for _, packet := range recvPackets {
+		if packet.DestinationPort != portID {
+			continue
+		}
+		var data transfertypes.FungibleTokenPacketData
+		if err := transfertypes.ModuleCdc.UnmarshalJSON(packet.Data, &data); err != nil {
+			continue
+		}
+		// the token is unescrowed instead of minted if this chain is the source of the token
+		if strings.HasPrefix(data.Denom, transfertypes.GetDenomPrefix(packet.SourcePort, packet.SourceChannel)) {
+			continue
+		}
+		add(transfertypes.ParseDenomTrace(transfertypes.GetPrefixedDenom(packet.DestinationPort, packet.DestinationChannel, data.Denom)))
+	}
+
+	for _, packet := range sentPackets {
+		if packet.SourcePort != portID {
+			continue
+		}
+		var data transfertypes.FungibleTokenPacketData
+		if err := transfertypes.ModuleCdc.UnmarshalJSON(packet.Data, &data); err != nil {
+			continue
+		}
+		// only native tokens are escrowed and vouchers are burned
+		if trace := transfertypes.ParseDenomTrace(data.Denom); trace.IsNativeDenom() {
+			add(trace)
+		}
+	}
+
+	return traces.Sort()
+}
+
+func paginateDenomTraces(traces transfertypes.Traces, offset uint64, limit uint64) transfertypes.Traces {
+	if offset >= uint64(len(traces)) {
+		return transfertypes.Traces{}
+	}
+	traces = traces[offset:]
+	if limit > 0 && limit < uint64(len(traces)) {
+		traces = traces[:limit]
+	}
+	return traces
+}
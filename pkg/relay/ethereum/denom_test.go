@@ -0,0 +1,43 @@
+package ethereum
+
+import (
+	"testing"
+
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	chantypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCollectDenomTraces(t *testing.T) {
+	packetData := func(denom string) []byte {
+		return transfertypes.NewFungibleTokenPacketData(denom, "100", "sender", "receiver", "").GetBytes()
+	}
+	recvPackets := []chantypes.Packet{
+		// minted
+		{SourcePort: "transfer", SourceChannel: "channel-1", DestinationPort: "transfer", DestinationChannel: "channel-0", Data: packetData("uatom")},
+		{SourcePort: "transfer", SourceChannel: "channel-1", DestinationPort: "transfer", DestinationChannel: "channel-0", Data: packetData("uatom")},
+		// unescrowed
+		{SourcePort: "transfer", SourceChannel: "channel-1", DestinationPort: "transfer", DestinationChannel: "channel-0", Data: packetData("transfer/channel-1/0xabc")},
+		// other port
+		{SourcePort: "other", SourceChannel: "channel-2", DestinationPort: "other", DestinationChannel: "channel-3", Data: packetData("uosmo")},
+		// invalid data
+		{SourcePort: "transfer", SourceChannel: "channel-1", DestinationPort: "transfer", DestinationChannel: "channel-0", Data: []byte("invalid")},
+	}
+	sentPackets := []chantypes.Packet{
+		// escrowed
+		{SourcePort: "transfer", SourceChannel: "channel-0", Data: packetData("0xabc")},
+		// burned
+		{SourcePort: "transfer", SourceChannel: "channel-0", Data: packetData("transfer/channel-0/uatom")},
+	}
+
+	traces := collectDenomTraces("transfer", recvPackets, sentPackets)
+	require.Equal(t, transfertypes.Traces{
+		{Path: "", BaseDenom: "0xabc"},
+		{Path: "transfer/channel-0", BaseDenom: "uatom"},
+	}, traces)
+
+	require.Equal(t, traces, paginateDenomTraces(traces, 0, 0))
+	require.Equal(t, traces[1:], paginateDenomTraces(traces, 1, 0))
+	require.Equal(t, traces[:1], paginateDenomTraces(traces, 0, 1))
+	require.Empty(t, paginateDenomTraces(traces, 2, 1))
+}
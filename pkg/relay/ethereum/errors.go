@@ -0,0 +1,25 @@
+package ethereum
+
+import (
+	"errors"
+
+	"github.com/datachainlab/ethereum-ibc-relay-chain/pkg/client"
+)
+
+var (
+	// ErrUnsupportedMsg is returned when a msg that the chain cannot handle is given
+	ErrUnsupportedMsg = errors.New("unsupported msg type")
+	// ErrUnsupportedTxType is returned when `tx_type` in the config or the type of a given tx is not supported.
+	// It is the same error as the one returned by the client.
+	ErrUnsupportedTxType = client.ErrUnsupportedTxType
+	// ErrNotSupported is returned when the requested operation is not supported by the chain
+	ErrNotSupported = errors.New("not supported")
+	// ErrInsufficientBalance is returned when the balance of the relayer account is below `min_balance` in the config
+	ErrInsufficientBalance = errors.New("insufficient balance")
+	// ErrFeeBudgetExceeded is returned when the fees paid recently exceed `fee_budget` in the config
+	ErrFeeBudgetExceeded = errors.New("fee budget exceeded")
+	// ErrTopUpRateLimited is returned when a top-up of the relayer account is requested too soon after the last one
+	ErrTopUpRateLimited = errors.New("top-up rate limited")
+	// ErrTxPolicyViolation is returned when a tx violates `tx_policy` in the config
+	ErrTxPolicyViolation = errors.New("tx policy violation")
+)
@@ -30,6 +30,7 @@ var (
 	abiRecvPacket,
 	abiWriteAcknowledgement,
 	abiAcknowledgePacket,
+	abiTimeoutPacket,
 	abiChannelUpgradeOpen abi.Event
 )
 
@@ -45,6 +46,7 @@ func init() {
 	abiRecvPacket = abiIBCHandler.Events["RecvPacket"]
 	abiWriteAcknowledgement = abiIBCHandler.Events["WriteAcknowledgement"]
 	abiAcknowledgePacket = abiIBCHandler.Events["AcknowledgePacket"]
+	abiTimeoutPacket = abiIBCHandler.Events["TimeoutPacket"]
 	abiChannelUpgradeOpen = abiIBCHandler.Events["ChannelUpgradeOpen"]
 }
 
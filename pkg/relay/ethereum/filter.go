@@ -0,0 +1,116 @@
+package ethereum
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	chantypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	"github.com/hyperledger-labs/yui-relayer/core"
+)
+
+// findRedundantMsgs checks packet msgs against the chain state at the latest height
+// and returns a flag for each msg that indicates whether the msg has already been processed
+// (e.g. by another relayer). Msgs other than MsgRecvPacket, MsgAcknowledgement, MsgTimeout
+// and MsgTimeoutOnClose are never regarded as redundant.
+func (c *Chain) findRedundantMsgs(ctx context.Context, msgs []sdk.Msg) ([]bool, error) {
+	redundant := make([]bool, len(msgs))
+	if c.pathEnd == nil {
+		return redundant, nil
+	}
+
+	var recvSeqs, ackSeqs []uint64
+	for _, msg := range msgs {
+		switch msg := msg.(type) {
+		case *chantypes.MsgRecvPacket:
+			if c.isDestinationOf(msg.Packet) {
+				recvSeqs = append(recvSeqs, msg.Packet.Sequence)
+			}
+		case *chantypes.MsgAcknowledgement:
+			if c.isSourceOf(msg.Packet) {
+				ackSeqs = append(ackSeqs, msg.Packet.Sequence)
+			}
+		case *chantypes.MsgTimeout:
+			if c.isSourceOf(msg.Packet) {
+				ackSeqs = append(ackSeqs, msg.Packet.Sequence)
+			}
+		case *chantypes.MsgTimeoutOnClose:
+			if c.isSourceOf(msg.Packet) {
+				ackSeqs = append(ackSeqs, msg.Packet.Sequence)
+			}
+		}
+	}
+	if len(recvSeqs) == 0 && len(ackSeqs) == 0 {
+		return redundant, nil
+	}
+
+	latestHeight, err := c.LatestHeight(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get latest height: %w", err)
+	}
+	queryCtx := core.NewQueryContext(ctx, latestHeight)
+
+	// packets that have not been received yet
+	var unreceived []uint64
+	if len(recvSeqs) > 0 {
+		if unreceived, err = c.QueryUnreceivedPackets(queryCtx, recvSeqs); err != nil {
+			return nil, fmt.Errorf("failed to query unreceived packets: %w", err)
+		}
+	}
+	// packets whose commitments still exist, which means that they have been neither acknowledged nor timed out
+	var uncommitted []uint64
+	if len(ackSeqs) > 0 {
+		if uncommitted, err = c.QueryUnreceivedAcknowledgements(queryCtx, ackSeqs); err != nil {
+			return nil, fmt.Errorf("failed to query unreceived acknowledgements: %w", err)
+		}
+	}
+
+	for i, msg := range msgs {
+		switch msg := msg.(type) {
+		case *chantypes.MsgRecvPacket:
+			redundant[i] = c.isDestinationOf(msg.Packet) && !slices.Contains(unreceived, msg.Packet.Sequence)
+		case *chantypes.MsgAcknowledgement:
+			redundant[i] = c.isSourceOf(msg.Packet) && !slices.Contains(uncommitted, msg.Packet.Sequence)
+		case *chantypes.MsgTimeout:
+			redundant[i] = c.isSourceOf(msg.Packet) && !slices.Contains(uncommitted, msg.Packet.Sequence)
+		case *chantypes.MsgTimeoutOnClose:
+			redundant[i] = c.isSourceOf(msg.Packet) && !slices.Contains(uncommitted, msg.Packet.Sequence)
+		}
+	}
+	return redundant, nil
+}
+
+func (c *Chain) isSourceOf(packet chantypes.Packet) bool {
+	return packet.SourcePort == c.pathEnd.PortID && packet.SourceChannel == c.pathEnd.ChannelID
+}
+
+func (c *Chain) isDestinationOf(packet chantypes.Packet) bool {
+	return packet.DestinationPort == c.pathEnd.PortID && packet.DestinationChannel == c.pathEnd.ChannelID
+}
+
+// filterMsgs returns msgs that are not flagged in `redundant`
+func filterMsgs(msgs []sdk.Msg, redundant []bool) []sdk.Msg {
+	ret := make([]sdk.Msg, 0, len(msgs))
+	for i, msg := range msgs {
+		if !redundant[i] {
+			ret = append(ret, msg)
+		}
+	}
+	return ret
+}
+
+// mergeNoopMsgIDs returns msg IDs corresponding to all the original msgs.
+// Redundant msgs are given no-op msg IDs and the others are given `msgIDs` in order.
+func mergeNoopMsgIDs(redundant []bool, msgIDs []core.MsgID) []core.MsgID {
+	ret := make([]core.MsgID, 0, len(redundant))
+	for _, r := range redundant {
+		if r {
+			ret = append(ret, NewNoopMsgID())
+		} else {
+			ret = append(ret, msgIDs[0])
+			msgIDs = msgIDs[1:]
+		}
+	}
+	return ret
+}
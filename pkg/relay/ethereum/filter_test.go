@@ -0,0 +1,142 @@
+package ethereum
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	chantypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/hyperledger-labs/yui-relayer/core"
+	"github.com/hyperledger-labs/yui-relayer/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/datachainlab/ethereum-ibc-relay-chain/pkg/contract/ibchandler"
+)
+
+func TestFilterMsgs(t *testing.T) {
+	msgs := []sdk.Msg{
+		&chantypes.MsgRecvPacket{Packet: chantypes.Packet{Sequence: 1}},
+		&chantypes.MsgRecvPacket{Packet: chantypes.Packet{Sequence: 2}},
+		&chantypes.MsgRecvPacket{Packet: chantypes.Packet{Sequence: 3}},
+	}
+	redundant := []bool{true, false, true}
+
+	filtered := filterMsgs(msgs, redundant)
+	require.Len(t, filtered, 1)
+	require.Equal(t, uint64(2), filtered[0].(*chantypes.MsgRecvPacket).Packet.Sequence)
+
+	msgIDs := mergeNoopMsgIDs(redundant, []core.MsgID{NewMsgID(common.HexToHash("0x1234"))})
+	require.Len(t, msgIDs, len(msgs))
+	require.True(t, msgIDs[0].(*MsgID).IsNoop())
+	require.False(t, msgIDs[1].(*MsgID).IsNoop())
+	require.Equal(t, common.HexToHash("0x1234"), msgIDs[1].(*MsgID).TxHash())
+	require.True(t, msgIDs[2].(*MsgID).IsNoop())
+}
+
+// filterTestEthService reports the packets in `received` as received and the packets in `committed` as committed,
+// and fails all the calls if `fail` is true
+type filterTestEthService struct {
+	signerPoolTestEthService
+	received  []uint64
+	committed []uint64
+	fail      bool
+}
+
+func (s *filterTestEthService) BlockNumber() (hexutil.Uint64, error) {
+	return 100, nil
+}
+
+func (s *filterTestEthService) Call(args map[string]interface{}, block string) (hexutil.Bytes, error) {
+	if s.fail {
+		return nil, errors.New("call failed")
+	}
+	input, err := hexutil.Decode(args["input"].(string))
+	require.NoError(s.t, err)
+	ibcHandlerABI, err := ibchandler.IbchandlerMetaData.GetAbi()
+	require.NoError(s.t, err)
+	method, err := ibcHandlerABI.MethodById(input[:4])
+	require.NoError(s.t, err)
+	params, err := method.Inputs.Unpack(input[4:])
+	require.NoError(s.t, err)
+
+	switch method.Name {
+	case "getPacketReceipt":
+		receipt := PACKET_RECEIPT_NONE
+		if slices.Contains(s.received, params[2].(uint64)) {
+			receipt = PACKET_RECEIPT_SUCCESSFUL
+		}
+		return method.Outputs.Pack(receipt)
+	case "getCommitment":
+		var commitment [32]byte
+		for _, seq := range s.committed {
+			if params[0].([32]byte) == crypto.Keccak256Hash(host.PacketCommitmentKey("transfer", "channel-0", seq)) {
+				commitment[0] = 1
+			}
+		}
+		return method.Outputs.Pack(commitment)
+	default:
+		s.t.Fatalf("unexpected call: %v", method.Name)
+		return nil, nil
+	}
+}
+
+func TestFindRedundantMsgs(t *testing.T) {
+	if err := log.InitLogger("DEBUG", "text", "null", false); err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	c := newAuditTestChain(t)
+	c.pathEnd.Order = "unordered"
+	c.connectionOpenedConfirmed = true
+	service := &filterTestEthService{
+		signerPoolTestEthService: signerPoolTestEthService{t: t},
+		received:                 []uint64{1},
+		committed:                []uint64{4},
+	}
+	withTestEthService(t, c, service)
+	// each msg is sent in its own tx
+	c.multicall3 = nil
+
+	packet := func(sequence uint64, channel string) chantypes.Packet {
+		return chantypes.Packet{Sequence: sequence, SourcePort: "transfer", SourceChannel: channel, DestinationPort: "transfer", DestinationChannel: channel}
+	}
+	msgs := []sdk.Msg{
+		&chantypes.MsgRecvPacket{Packet: packet(1, "channel-0")},
+		&chantypes.MsgRecvPacket{Packet: packet(2, "channel-0")},
+		&chantypes.MsgAcknowledgement{Packet: packet(3, "channel-0")},
+		&chantypes.MsgTimeout{Packet: packet(4, "channel-0")},
+		// packets of another channel are not checked
+		&chantypes.MsgRecvPacket{Packet: packet(5, "channel-1")},
+	}
+
+	// received packets and packets without commitments are redundant
+	redundant, err := c.findRedundantMsgs(ctx, msgs)
+	require.NoError(t, err)
+	require.Equal(t, []bool{true, false, true, false, false}, redundant)
+
+	// only the msgs that are not redundant are sent
+	msgIDs, err := c.SendMsgs(ctx, msgs)
+	require.NoError(t, err)
+	require.Len(t, service.sent, 3)
+	require.Len(t, msgIDs, len(msgs))
+	for i, r := range redundant {
+		require.Equal(t, r, msgIDs[i].(*MsgID).IsNoop(), i)
+	}
+
+	// all the msgs are sent if the queries fail
+	service.fail = true
+	_, err = c.findRedundantMsgs(ctx, msgs)
+	require.Error(t, err)
+	msgIDs, err = c.SendMsgs(ctx, msgs)
+	require.NoError(t, err)
+	require.Len(t, service.sent, 3+len(msgs))
+	for i := range msgs {
+		require.False(t, msgIDs[i].(*MsgID).IsNoop(), i)
+	}
+}
@@ -2,8 +2,10 @@ package ethereum
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math/big"
+	"slices"
 
 	"github.com/datachainlab/ethereum-ibc-relay-chain/pkg/client/txpool"
 	"github.com/ethereum/go-ethereum"
@@ -16,12 +18,14 @@ const basefeeWiggleMultiplier = 2
 type GasFeeCalculator struct {
 	client IChainClient
 	config *ChainConfig
+	oracle GasOracle
 }
 
 func NewGasFeeCalculator(client IChainClient, config *ChainConfig) *GasFeeCalculator {
 	return &GasFeeCalculator{
 		client: client,
 		config: config,
+		oracle: NewGasOracle(client, config),
 	}
 }
 
@@ -36,7 +40,8 @@ func (m *GasFeeCalculator) Apply(ctx context.Context, txOpts *bind.TransactOpts)
 		}
 	}
 	switch m.config.TxType {
-	case TxTypeLegacy:
+	case TxTypeLegacy, TxTypeAccessList:
+		// access lists are attached after the gas estimation
 		gasPrice, err := m.calculateGasPrice(ctx, oldTx, minFeeCap)
 		if err != nil {
 			return fmt.Errorf("failed to calculate gas price: %v", err)
@@ -44,15 +49,29 @@ func (m *GasFeeCalculator) Apply(ctx context.Context, txOpts *bind.TransactOpts)
 		txOpts.GasPrice = gasPrice
 		return nil
 	case TxTypeDynamic:
-		gasTipCap, gasFeeCap, err := m.feeHistory(ctx)
+		gasTipCap, gasFeeCap, err := m.oracle.SuggestDynamicFee(ctx)
 		if err != nil {
 			return err
+		} else if gasFeeCap == nil {
+			return errors.New("base fee is not available for dynamic fee txs")
 		}
 		// GasTipCap = min(LimitPriorityFeePerGas, simulated_eth_maxPriorityFeePerGas * PriorityFeeRate)
 		m.config.DynamicTxGasConfig.PriorityFeeRate.Mul(gasTipCap)
 
 		// GasFeeCap = min(LimitFeePerGas, GasTipCap + BaseFee * BaseFeeRate)
 		m.config.DynamicTxGasConfig.BaseFeeRate.Mul(gasFeeCap)
+
+		// both rates are scaled by the urgency tier of the tx, and the results are still capped by the limits
+		if blocks, ok := blocksToTimeoutFrom(ctx); ok {
+			if tier := m.config.DynamicTxGasConfig.urgencyTier(blocks); tier != nil {
+				if tier.PriorityFeeMultiplier != nil {
+					tier.PriorityFeeMultiplier.Mul(gasTipCap)
+				}
+				if tier.BaseFeeMultiplier != nil {
+					tier.BaseFeeMultiplier.Mul(gasFeeCap)
+				}
+			}
+		}
 		gasFeeCap.Add(gasFeeCap, gasTipCap)
 
 		gasTipCap, gasFeeCap, err = m.applyMinGasCaps(oldTx, gasTipCap, gasFeeCap, minTipCap, minFeeCap)
@@ -75,12 +94,12 @@ func (m *GasFeeCalculator) Apply(ctx context.Context, txOpts *bind.TransactOpts)
 		return nil
 	case TxTypeAuto:
 		// Calculate gas options in the same way as bind.BoundContract.transact
-		head, err := m.client.HeaderByNumber(ctx, nil)
+		gasTipCap, baseFee, err := m.oracle.SuggestDynamicFee(ctx)
 		if err != nil {
-			return fmt.Errorf("failed to get latest header: %v", err)
+			return err
 		}
 
-		if head.BaseFee == nil {
+		if baseFee == nil {
 			gasPrice, err := m.calculateGasPrice(ctx, oldTx, minFeeCap)
 			if err != nil {
 				return fmt.Errorf("failed to calculate gas price: %v", err)
@@ -88,13 +107,9 @@ func (m *GasFeeCalculator) Apply(ctx context.Context, txOpts *bind.TransactOpts)
 			txOpts.GasPrice = gasPrice
 			return nil
 		} else {
-			gasTipCap, err := m.client.SuggestGasTipCap(ctx)
-			if err != nil {
-				return fmt.Errorf("failed to suggest gas tip cap: %v", err)
-			}
 			gasFeeCap := new(big.Int).Add(
 				gasTipCap,
-				new(big.Int).Mul(head.BaseFee, big.NewInt(basefeeWiggleMultiplier)),
+				new(big.Int).Mul(baseFee, big.NewInt(basefeeWiggleMultiplier)),
 			)
 
 			gasTipCap, gasFeeCap, err = m.applyMinGasCaps(oldTx, gasTipCap, gasFeeCap, minTipCap, minFeeCap)
@@ -107,14 +122,22 @@ func (m *GasFeeCalculator) Apply(ctx context.Context, txOpts *bind.TransactOpts)
 			return nil
 		}
 	default:
-		panic("unsupported tx type")
+		return fmt.Errorf("%w: %s", ErrUnsupportedTxType, m.config.TxType)
 	}
 }
 
 func (m *GasFeeCalculator) calculateGasPrice(ctx context.Context, oldTx *txpool.RPCTransaction, minFeeCap *big.Int) (*big.Int, error) {
-	gasPrice, err := m.client.SuggestGasPrice(ctx)
+	gasPrice, err := m.oracle.SuggestGasPrice(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to suggest gas price: %v", err)
+		return nil, err
+	}
+	legacyConfig := m.config.LegacyTxGasConfig
+	if legacyConfig != nil {
+		// GasPrice = max(MinGasPrice, simulated_eth_gasPrice * GasPriceRate)
+		legacyConfig.GasPriceRate.Mul(gasPrice)
+		if l := legacyConfig.GetMinGasPrice(); gasPrice.Cmp(l) < 0 {
+			gasPrice = l
+		}
 	}
 	if oldTx != nil && oldTx.GasPrice != nil && oldTx.GasPrice.ToInt().Cmp(gasPrice) > 0 {
 		// Since the old tx's gas price is already higher than the suggested value,
@@ -125,29 +148,14 @@ func (m *GasFeeCalculator) calculateGasPrice(ctx context.Context, oldTx *txpool.
 	if gasPrice.Cmp(minFeeCap) < 0 {
 		gasPrice = minFeeCap
 	}
-
-	return gasPrice, nil
-}
-
-func (m *GasFeeCalculator) feeHistory(ctx context.Context) (*big.Int, *big.Int, error) {
-	rewardPercentile := float64(m.config.DynamicTxGasConfig.FeeHistoryRewardPercentile)
-	maxRetry := m.config.DynamicTxGasConfig.MaxRetryForFeeHistory
-
-	latest, hErr := m.client.HeaderByNumber(ctx, nil)
-	if hErr != nil {
-		return nil, nil, fmt.Errorf("failed to get latest header: %v", hErr)
-	}
-	for i := uint32(0); i < maxRetry+1; i++ {
-		block := big.NewInt(0).Sub(latest.Number, big.NewInt(int64(i)))
-		history, err := m.client.FeeHistory(ctx, 1, block, []float64{rewardPercentile})
-		if err != nil {
-			return nil, nil, fmt.Errorf("failed to get feeHistory: %v", err)
-		}
-		if gasTipCap, baseFee, ok := getFeeInfo(history); ok {
-			return gasTipCap, baseFee, nil
+	// the limit takes precedence over the price bump in the same way as the limits of dynamic fee txs
+	if legacyConfig != nil {
+		if l := legacyConfig.GetLimitGasPrice(); l.Sign() > 0 && gasPrice.Cmp(l) > 0 {
+			gasPrice = l
 		}
 	}
-	return nil, nil, fmt.Errorf("no fee was found: latest=%v, maxRetry=%d", latest, maxRetry)
+
+	return gasPrice, nil
 }
 
 func getFeeInfo(v *ethereum.FeeHistory) (*big.Int, *big.Int, bool) {
@@ -165,6 +173,39 @@ func getFeeInfo(v *ethereum.FeeHistory) (*big.Int, *big.Int, bool) {
 	return gasTipCap, baseFee, true
 }
 
+// getFeeInfoFromWindow returns the median of the rewards in the blocks with non-zero rewards and the base fee of the next block
+func getFeeInfoFromWindow(v *ethereum.FeeHistory) (*big.Int, *big.Int, bool) {
+	var rewards []*big.Int
+	for _, r := range v.Reward {
+		// blocks without txs paying a priority fee don't tell the market price
+		if len(r) > 0 && r[0].Sign() > 0 {
+			rewards = append(rewards, r[0])
+		}
+	}
+	if len(rewards) == 0 {
+		return nil, nil, false
+	}
+
+	// history.BaseFee has one more element than history.Reward, which is the base fee of the next block
+	if len(v.BaseFee) <= len(v.Reward) || len(v.BaseFee) == 0 {
+		return nil, nil, false
+	}
+	nextBaseFee := new(big.Int).Set(v.BaseFee[len(v.BaseFee)-1])
+	return median(rewards), nextBaseFee, true
+}
+
+// median returns the median of the values, which is the mean of the two middle values if the number of the values is even
+func median(values []*big.Int) *big.Int {
+	sorted := slices.Clone(values)
+	slices.SortFunc(sorted, func(a, b *big.Int) int { return a.Cmp(b) })
+	mid := len(sorted) / 2
+	if len(sorted)%2 == 1 {
+		return new(big.Int).Set(sorted[mid])
+	}
+	sum := new(big.Int).Add(sorted[mid-1], sorted[mid])
+	return sum.Div(sum, big.NewInt(2))
+}
+
 func (m *GasFeeCalculator) applyMinGasCaps(
 	oldTx *txpool.RPCTransaction,
 	gasTipCap *big.Int,
@@ -0,0 +1,261 @@
+package ethereum
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"time"
+
+	"github.com/ethereum/go-ethereum/params"
+)
+
+const (
+	GasOracleNode       = "node"
+	GasOracleFeeHistory = "fee_history"
+	GasOracleGasStation = "gas_station"
+)
+
+// GasOracle suggests gas fees from which GasFeeCalculator calculates the fees of txs
+type GasOracle interface {
+	// SuggestGasPrice returns the gas price for legacy txs
+	SuggestGasPrice(ctx context.Context) (*big.Int, error)
+	// SuggestDynamicFee returns the priority fee per gas and the base fee per gas for dynamic fee txs.
+	// Both are nil if the chain doesn't support EIP-1559.
+	SuggestDynamicFee(ctx context.Context) (gasTipCap *big.Int, baseFee *big.Int, err error)
+}
+
+// NewGasOracle returns the GasOracle selected by `gas_oracle` in the config
+//
+// CONTRACT: config.Validate() must be called before calling this function.
+func NewGasOracle(client IChainClient, config *ChainConfig) GasOracle {
+	switch config.GasOracleOrDefault() {
+	case GasOracleFeeHistory:
+		return &FeeHistoryGasOracle{client: client, config: config.DynamicTxGasConfig}
+	case GasOracleGasStation:
+		// the node is used if the gas station is unavailable
+		fallback := *config
+		fallback.GasOracle = ""
+		return &GasStationGasOracle{
+			client:   client,
+			config:   config.GasStation,
+			fallback: NewGasOracle(client, &fallback),
+		}
+	default:
+		return &NodeGasOracle{client: client}
+	}
+}
+
+// NodeGasOracle uses the suggestions of the node in the same way as bind.BoundContract.transact
+type NodeGasOracle struct {
+	client IChainClient
+}
+
+var _ GasOracle = (*NodeGasOracle)(nil)
+
+func (o *NodeGasOracle) SuggestGasPrice(ctx context.Context) (*big.Int, error) {
+	gasPrice, err := o.client.SuggestGasPrice(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to suggest gas price: %v", err)
+	}
+	return gasPrice, nil
+}
+
+func (o *NodeGasOracle) SuggestDynamicFee(ctx context.Context) (*big.Int, *big.Int, error) {
+	head, err := o.client.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get latest header: %v", err)
+	}
+	if head.BaseFee == nil {
+		return nil, nil, nil
+	}
+	gasTipCap, err := o.client.SuggestGasTipCap(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to suggest gas tip cap: %v", err)
+	}
+	return gasTipCap, new(big.Int).Set(head.BaseFee), nil
+}
+
+// FeeHistoryGasOracle suggests the priority fee at a percentile of the rewards in the latest block
+// that contains any tx paying a priority fee, or the median of the percentiles in a window of blocks
+// if `fee_history_block_count` is more than one
+type FeeHistoryGasOracle struct {
+	client IChainClient
+	config *DynamicTxGasConfig
+}
+
+var _ GasOracle = (*FeeHistoryGasOracle)(nil)
+
+func (o *FeeHistoryGasOracle) SuggestGasPrice(ctx context.Context) (*big.Int, error) {
+	return (&NodeGasOracle{client: o.client}).SuggestGasPrice(ctx)
+}
+
+func (o *FeeHistoryGasOracle) SuggestDynamicFee(ctx context.Context) (*big.Int, *big.Int, error) {
+	rewardPercentile := float64(o.config.FeeHistoryRewardPercentile)
+	maxRetry := o.config.MaxRetryForFeeHistory
+
+	latest, hErr := o.client.HeaderByNumber(ctx, nil)
+	if hErr != nil {
+		return nil, nil, fmt.Errorf("failed to get latest header: %v", hErr)
+	}
+	if blockCount := o.config.FeeHistoryBlockCount; blockCount > 1 {
+		history, err := o.client.FeeHistory(ctx, uint64(blockCount), latest.Number, []float64{rewardPercentile})
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to get feeHistory: %v", err)
+		}
+		if gasTipCap, nextBaseFee, ok := getFeeInfoFromWindow(history); ok {
+			return gasTipCap, nextBaseFee, nil
+		}
+		return nil, nil, fmt.Errorf("no fee was found: latest=%v, blockCount=%d", latest.Number, blockCount)
+	}
+	for i := uint32(0); i < maxRetry+1; i++ {
+		block := big.NewInt(0).Sub(latest.Number, big.NewInt(int64(i)))
+		history, err := o.client.FeeHistory(ctx, 1, block, []float64{rewardPercentile})
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to get feeHistory: %v", err)
+		}
+		if gasTipCap, baseFee, ok := getFeeInfo(history); ok {
+			return gasTipCap, baseFee, nil
+		}
+	}
+	return nil, nil, fmt.Errorf("no fee was found: latest=%v, maxRetry=%d", latest, maxRetry)
+}
+
+// GasStationGasOracle uses the suggestions of a gas station API, and falls back to another oracle
+// if the API is unavailable or its response is stale
+type GasStationGasOracle struct {
+	client   IChainClient
+	config   *GasStationConfig
+	fallback GasOracle
+}
+
+var _ GasOracle = (*GasStationGasOracle)(nil)
+
+type gasStationTier struct {
+	MaxPriorityFee json.Number `json:"maxPriorityFee"`
+	MaxFee         json.Number `json:"maxFee"`
+}
+
+type gasStationSuggestion struct {
+	gasTipCap *big.Int
+	gasFeeCap *big.Int
+	baseFee   *big.Int
+}
+
+func (o *GasStationGasOracle) SuggestGasPrice(ctx context.Context) (*big.Int, error) {
+	s, err := o.fetch(ctx)
+	if err != nil {
+		GetModuleLogger().WarnContext(ctx, "fall back to the node because the gas station is unavailable", "error", err)
+		return o.fallback.SuggestGasPrice(ctx)
+	}
+	// legacy txs pay the gas price as is, so the headroom for base fee increases in maxFee is not paid if the base fee is known
+	if s.baseFee != nil {
+		return new(big.Int).Add(s.baseFee, s.gasTipCap), nil
+	}
+	return s.gasFeeCap, nil
+}
+
+func (o *GasStationGasOracle) SuggestDynamicFee(ctx context.Context) (*big.Int, *big.Int, error) {
+	s, err := o.fetch(ctx)
+	if err == nil && s.baseFee == nil {
+		err = errors.New("estimatedBaseFee is missing")
+	}
+	if err != nil {
+		GetModuleLogger().WarnContext(ctx, "fall back to the node because the gas station is unavailable", "error", err)
+		return o.fallback.SuggestDynamicFee(ctx)
+	}
+	return s.gasTipCap, s.baseFee, nil
+}
+
+func (o *GasStationGasOracle) fetch(ctx context.Context) (*gasStationSuggestion, error) {
+	ctx, cancel := context.WithTimeout(ctx, o.config.GetTimeout())
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, o.config.Url, nil)
+	if err != nil {
+		return nil, err
+	}
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status: %s", res.Status)
+	}
+
+	var body map[string]json.RawMessage
+	if err := json.NewDecoder(res.Body).Decode(&body); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %v", err)
+	}
+
+	var blockNumber uint64
+	if raw, ok := body["blockNumber"]; !ok {
+		return nil, errors.New("blockNumber is missing")
+	} else if err := json.Unmarshal(raw, &blockNumber); err != nil {
+		return nil, fmt.Errorf("invalid blockNumber: %v", err)
+	}
+	head, err := o.client.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get latest header: %v", err)
+	}
+	if maxBlockLag, latest := o.config.GetMaxBlockLag(), head.Number.Uint64(); latest > blockNumber+maxBlockLag {
+		return nil, fmt.Errorf("response is stale: block_number=%d, latest=%d, max_block_lag=%d", blockNumber, latest, maxBlockLag)
+	}
+
+	var tier gasStationTier
+	if raw, ok := body[o.config.Speed]; !ok {
+		return nil, fmt.Errorf("speed tier is missing: %s", o.config.Speed)
+	} else if err := json.Unmarshal(raw, &tier); err != nil {
+		return nil, fmt.Errorf("invalid speed tier: %v", err)
+	}
+	gasTipCap, err := parseGwei(tier.MaxPriorityFee)
+	if err != nil {
+		return nil, fmt.Errorf("invalid maxPriorityFee: %v", err)
+	}
+	gasFeeCap, err := parseGwei(tier.MaxFee)
+	if err != nil {
+		return nil, fmt.Errorf("invalid maxFee: %v", err)
+	}
+	s := &gasStationSuggestion{gasTipCap: gasTipCap, gasFeeCap: gasFeeCap}
+	if raw, ok := body["estimatedBaseFee"]; ok {
+		var baseFee json.Number
+		if err := json.Unmarshal(raw, &baseFee); err != nil {
+			return nil, fmt.Errorf("invalid estimatedBaseFee: %v", err)
+		}
+		if s.baseFee, err = parseGwei(baseFee); err != nil {
+			return nil, fmt.Errorf("invalid estimatedBaseFee: %v", err)
+		}
+	}
+	return s, nil
+}
+
+// parseGwei converts a decimal amount in gwei into wei
+func parseGwei(n json.Number) (*big.Int, error) {
+	f, ok := new(big.Float).SetPrec(256).SetString(n.String())
+	if !ok {
+		return nil, fmt.Errorf("invalid number: %q", n)
+	} else if f.Sign() < 0 {
+		return nil, fmt.Errorf("negative amount: %s", n)
+	}
+	wei, _ := f.Mul(f, new(big.Float).SetInt64(params.GWei)).Int(nil)
+	return wei, nil
+}
+
+// GetMaxBlockLag returns the maximum number of blocks by which the response may lag behind the latest block
+func (c *GasStationConfig) GetMaxBlockLag() uint64 {
+	if c.MaxBlockLag == 0 {
+		return 3
+	}
+	return c.MaxBlockLag
+}
+
+// GetTimeout returns the timeout of the API request
+func (c *GasStationConfig) GetTimeout() time.Duration {
+	if c.TimeoutMsec == 0 {
+		return 3 * time.Second
+	}
+	return time.Duration(c.TimeoutMsec) * time.Millisecond
+}
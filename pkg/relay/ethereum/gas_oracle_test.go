@@ -0,0 +1,182 @@
+package ethereum
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/hyperledger-labs/yui-relayer/log"
+	"github.com/stretchr/testify/require"
+)
+
+func gwei(n int64) *big.Int {
+	return new(big.Int).Mul(big.NewInt(n), big.NewInt(1_000_000_000))
+}
+
+func newGasStationStub(t *testing.T, status *int, blockNumber *uint64) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if *status != http.StatusOK {
+			w.WriteHeader(*status)
+			return
+		}
+		fmt.Fprintf(w, `{"safeLow":{"maxPriorityFee":1,"maxFee":2},"fast":{"maxPriorityFee":30.5,"maxFee":31.9},"estimatedBaseFee":0.7,"blockNumber":%d}`, *blockNumber)
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestGasStationGasOracle(t *testing.T) {
+	if err := log.InitLogger("DEBUG", "text", "null", false); err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	status := http.StatusOK
+	blockNumber := uint64(998)
+	server := newGasStationStub(t, &status, &blockNumber)
+
+	cli := MockChainClient{}
+	cli.MockLatestHeaderNumber.SetUint64(1000)
+	cli.MockSuggestGasPrice.Set(gwei(5))
+	cli.MockHistoryGasTipCap.Set(gwei(3))
+	cli.MockHistoryGasFeeCap.Set(gwei(4))
+
+	config := createConfig()
+	config.GasOracle = GasOracleGasStation
+	config.GasStation = &GasStationConfig{Url: server.URL, Speed: "fast", MaxBlockLag: 2}
+	require.NoError(t, config.GasStation.ValidateBasic())
+	oracle := NewGasOracle(&cli, config)
+
+	// the suggestions of the gas station are used while it is fresh
+	gasPrice, err := oracle.SuggestGasPrice(ctx)
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(30_500_000_000+700_000_000), gasPrice)
+	gasTipCap, baseFee, err := oracle.SuggestDynamicFee(ctx)
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(30_500_000_000), gasTipCap)
+	require.Equal(t, big.NewInt(700_000_000), baseFee)
+
+	calculator := NewGasFeeCalculator(&cli, config)
+	txOpts := &bind.TransactOpts{}
+	require.NoError(t, calculator.Apply(ctx, txOpts))
+	require.Equal(t, big.NewInt(30_500_000_000), txOpts.GasTipCap)
+	require.Equal(t, big.NewInt(30_500_000_000+2*700_000_000), txOpts.GasFeeCap)
+
+	// the node is used if the response is stale or the gas station is unavailable
+	for _, c := range []struct {
+		status      int
+		blockNumber uint64
+	}{
+		{http.StatusOK, 997},
+		{http.StatusInternalServerError, 1000},
+	} {
+		status, blockNumber = c.status, c.blockNumber
+		gasPrice, err := oracle.SuggestGasPrice(ctx)
+		require.NoError(t, err)
+		require.Equal(t, gwei(5), gasPrice)
+		gasTipCap, baseFee, err := oracle.SuggestDynamicFee(ctx)
+		require.NoError(t, err)
+		require.Equal(t, gwei(3), gasTipCap)
+		require.Equal(t, gwei(4), baseFee)
+	}
+
+	// the speed tier must be in the response
+	status, blockNumber = http.StatusOK, 1000
+	config.GasStation.Speed = "instant"
+	gasTipCap, _, err = NewGasOracle(&cli, config).SuggestDynamicFee(ctx)
+	require.NoError(t, err)
+	require.Equal(t, gwei(3), gasTipCap)
+
+	// the freshness is checked with the default lag if max_block_lag is not set
+	config.GasStation = &GasStationConfig{Url: server.URL, Speed: "fast"}
+	oracle = NewGasOracle(&cli, config)
+	blockNumber = 997
+	gasTipCap, _, err = oracle.SuggestDynamicFee(ctx)
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(30_500_000_000), gasTipCap)
+	blockNumber = 996
+	gasTipCap, _, err = oracle.SuggestDynamicFee(ctx)
+	require.NoError(t, err)
+	require.Equal(t, gwei(3), gasTipCap)
+}
+
+func TestGasOracleConfig(t *testing.T) {
+	config := createConfig()
+	require.Equal(t, GasOracleFeeHistory, config.GasOracleOrDefault())
+	require.IsType(t, &FeeHistoryGasOracle{}, NewGasOracle(&MockChainClient{}, config))
+	config.TxType = TxTypeAuto
+	require.Equal(t, GasOracleNode, config.GasOracleOrDefault())
+	require.IsType(t, &NodeGasOracle{}, NewGasOracle(&MockChainClient{}, config))
+
+	require.Error(t, (&GasStationConfig{Url: "ftp://example.com", Speed: "fast"}).ValidateBasic())
+	require.Error(t, (&GasStationConfig{Url: "https://example.com"}).ValidateBasic())
+	require.NoError(t, (&GasStationConfig{Url: "https://example.com", Speed: "fast"}).ValidateBasic())
+}
+
+// feeHistoryChainClient is a fake IChainClient that returns the fee history of the latest blocks
+type feeHistoryChainClient struct {
+	IChainClient
+	latest int64
+	// rewards and base fees of the blocks from genesis, and the base fee of the next block
+	rewards  []int64
+	baseFees []int64
+}
+
+func (cl *feeHistoryChainClient) HeaderByNumber(ctx context.Context, number *big.Int) (*gethtypes.Header, error) {
+	return &gethtypes.Header{Number: big.NewInt(cl.latest), BaseFee: big.NewInt(cl.baseFees[cl.latest])}, nil
+}
+
+func (cl *feeHistoryChainClient) FeeHistory(ctx context.Context, blockCount uint64, lastBlock *big.Int, rewardPercentiles []float64) (*ethereum.FeeHistory, error) {
+	oldest := lastBlock.Int64() - int64(blockCount) + 1
+	history := &ethereum.FeeHistory{OldestBlock: big.NewInt(oldest)}
+	for i := oldest; i <= lastBlock.Int64(); i++ {
+		history.Reward = append(history.Reward, []*big.Int{big.NewInt(cl.rewards[i])})
+		history.BaseFee = append(history.BaseFee, big.NewInt(cl.baseFees[i]))
+	}
+	history.BaseFee = append(history.BaseFee, big.NewInt(cl.baseFees[lastBlock.Int64()+1]))
+	return history, nil
+}
+
+func TestFeeHistoryWindow(t *testing.T) {
+	ctx := context.Background()
+	cli := &feeHistoryChainClient{
+		latest: 5,
+		// a noisy reward in the latest block and a block without rewards
+		rewards:  []int64{10, 12, 11, 0, 13, 1000},
+		baseFees: []int64{100, 100, 100, 100, 100, 100, 120},
+	}
+	config := createConfig().DynamicTxGasConfig
+
+	// only the latest block is used without a window
+	gasTipCap, baseFee, err := (&FeeHistoryGasOracle{client: cli, config: config}).SuggestDynamicFee(ctx)
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(1000), gasTipCap)
+	require.Equal(t, big.NewInt(100), baseFee)
+
+	// median of {11, 13, 1000} and the base fee of the next block
+	config.FeeHistoryBlockCount = 4
+	gasTipCap, baseFee, err = (&FeeHistoryGasOracle{client: cli, config: config}).SuggestDynamicFee(ctx)
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(13), gasTipCap)
+	require.Equal(t, big.NewInt(120), baseFee)
+
+	// mean of the two middle values of {10, 12, 11, 13, 1000, 1000} in an even window
+	cli.rewards = append(cli.rewards, 1000)
+	cli.baseFees = append(cli.baseFees, 150)
+	cli.latest = 6
+	config.FeeHistoryBlockCount = 7
+	gasTipCap, baseFee, err = (&FeeHistoryGasOracle{client: cli, config: config}).SuggestDynamicFee(ctx)
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(12), gasTipCap)
+	require.Equal(t, big.NewInt(150), baseFee)
+
+	// no fee is found if no block has rewards
+	cli.rewards = []int64{0, 0, 0, 0, 0, 0, 0}
+	_, _, err = (&FeeHistoryGasOracle{client: cli, config: config}).SuggestDynamicFee(ctx)
+	require.Error(t, err)
+}
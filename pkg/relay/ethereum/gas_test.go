@@ -2,6 +2,7 @@ package ethereum
 
 import (
 	"context"
+	"errors"
 	"math/big"
 	"testing"
 
@@ -432,3 +433,62 @@ func calculateBaseFee(suggestedGasTipCap, suggestedGasFeeCap int64) *big.Int {
 	//   <=> baseFee = (suggested gasFeeCap - suggested gasTipCap) / basefeeWiggleMultiplier
 	return big.NewInt((suggestedGasFeeCap - suggestedGasTipCap) / basefeeWiggleMultiplier)
 }
+
+func TestUnsupportedTxType(t *testing.T) {
+	cli := MockChainClient{}
+	config := createConfig()
+	config.TxType = "unknown"
+	calculator := NewGasFeeCalculator(&cli, config)
+
+	err := calculator.Apply(context.Background(), &bind.TransactOpts{})
+	if !errors.Is(err, ErrUnsupportedTxType) {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestLegacyTxGasConfig(t *testing.T) {
+	for _, txType := range []string{TxTypeLegacy, TxTypeAuto} {
+		cli := MockChainClient{}
+		config := createConfig()
+		config.TxType = txType
+		config.LegacyTxGasConfig = &LegacyTxGasConfig{
+			GasPriceRate:  &Fraction{Numerator: 3, Denominator: 2},
+			LimitGasPrice: "300wei",
+			MinGasPrice:   "100wei",
+		}
+		if err := config.LegacyTxGasConfig.ValidateBasic(); err != nil {
+			t.Fatal(err)
+		}
+		calculator := NewGasFeeCalculator(&cli, config)
+
+		for _, c := range []struct {
+			suggested uint64
+			gasPrice  uint64
+		}{
+			{50, 100},   // floored by min gas price
+			{100, 150},  // suggestion * 1.5
+			{1000, 300}, // capped by limit gas price
+		} {
+			cli.MockSuggestGasPrice.SetUint64(c.suggested)
+			txOpts := &bind.TransactOpts{}
+			if err := calculator.Apply(context.Background(), txOpts); err != nil {
+				t.Fatal(err)
+			}
+			if txOpts.GasPrice.Uint64() != c.gasPrice {
+				t.Errorf("%s: gasPrice should be %v but %v", txType, c.gasPrice, txOpts.GasPrice)
+			}
+		}
+	}
+
+	for _, invalid := range []*LegacyTxGasConfig{
+		{},
+		{GasPriceRate: &Fraction{Numerator: 1}},
+		{GasPriceRate: &Fraction{Numerator: 1, Denominator: 1}, LimitGasPrice: "1foo"},
+		{GasPriceRate: &Fraction{Numerator: 1, Denominator: 1}, MinGasPrice: "1foo"},
+		{GasPriceRate: &Fraction{Numerator: 1, Denominator: 1}, LimitGasPrice: "1gwei", MinGasPrice: "2gwei"},
+	} {
+		if err := invalid.ValidateBasic(); err == nil {
+			t.Errorf("config must be invalid: %v", invalid)
+		}
+	}
+}
@@ -0,0 +1,74 @@
+package ethereum
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	gethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/hyperledger-labs/yui-relayer/signer"
+)
+
+var _ signer.SignerConfig = (*KeystoreSignerConfig)(nil)
+
+func (c *KeystoreSignerConfig) Validate() error {
+	if strings.TrimSpace(c.Path) == "" {
+		return errors.New("config attribute \"path\" is empty")
+	}
+	if (c.PasswordFile == "") == (c.PasswordEnv == "") {
+		return errors.New("exactly one of config attributes \"password_file\" and \"password_env\" must be set")
+	}
+	return nil
+}
+
+// Build decrypts the keystore and returns a signer that holds the decrypted key in memory
+func (c *KeystoreSignerConfig) Build() (signer.Signer, error) {
+	keyJSON, err := os.ReadFile(c.Path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read keystore: %v", err)
+	}
+	password, err := c.password()
+	if err != nil {
+		return nil, err
+	}
+	key, err := keystore.DecryptKey(keyJSON, password)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt keystore: %v", err)
+	}
+	return &KeystoreSigner{privateKey: key.PrivateKey}, nil
+}
+
+func (c *KeystoreSignerConfig) password() (string, error) {
+	if c.PasswordFile != "" {
+		bz, err := os.ReadFile(c.PasswordFile)
+		if err != nil {
+			return "", fmt.Errorf("failed to read password file: %v", err)
+		}
+		// ignore a trailing newline added by editors
+		return strings.TrimRight(string(bz), "\r\n"), nil
+	}
+	password, ok := os.LookupEnv(c.PasswordEnv)
+	if !ok {
+		return "", fmt.Errorf("environment variable not found: %s", c.PasswordEnv)
+	}
+	return password, nil
+}
+
+// KeystoreSigner is a signer.Signer with a private key decrypted from a keystore
+type KeystoreSigner struct {
+	privateKey *ecdsa.PrivateKey
+}
+
+var _ signer.Signer = (*KeystoreSigner)(nil)
+
+func (s *KeystoreSigner) Sign(ctx context.Context, digest []byte) ([]byte, error) {
+	return gethcrypto.Sign(digest, s.privateKey)
+}
+
+func (s *KeystoreSigner) GetPublicKey(ctx context.Context) ([]byte, error) {
+	return gethcrypto.CompressPubkey(&s.privateKey.PublicKey), nil
+}
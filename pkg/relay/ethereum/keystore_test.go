@@ -0,0 +1,52 @@
+package ethereum
+
+import (
+	"context"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	gethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKeystoreSigner(t *testing.T) {
+	privateKey, err := gethcrypto.GenerateKey()
+	require.NoError(t, err)
+	key := &keystore.Key{
+		Id:         uuid.New(),
+		Address:    gethcrypto.PubkeyToAddress(privateKey.PublicKey),
+		PrivateKey: privateKey,
+	}
+	keyJSON, err := keystore.EncryptKey(key, "password", keystore.LightScryptN, keystore.LightScryptP)
+	require.NoError(t, err)
+
+	dir := t.TempDir()
+	keyPath := filepath.Join(dir, "key.json")
+	require.NoError(t, os.WriteFile(keyPath, keyJSON, 0600))
+	passwordPath := filepath.Join(dir, "password")
+	require.NoError(t, os.WriteFile(passwordPath, []byte("password\n"), 0600))
+	t.Setenv("TEST_KEYSTORE_PASSWORD", "password")
+
+	for _, config := range []*KeystoreSignerConfig{
+		{Path: keyPath, PasswordFile: passwordPath},
+		{Path: keyPath, PasswordEnv: "TEST_KEYSTORE_PASSWORD"},
+	} {
+		require.NoError(t, config.Validate())
+		bytesSigner, err := config.Build()
+		require.NoError(t, err)
+		s, err := NewEthereumSigner(context.Background(), bytesSigner, big.NewInt(1))
+		require.NoError(t, err)
+		require.Equal(t, key.Address, s.Address())
+	}
+
+	require.Error(t, (&KeystoreSignerConfig{Path: keyPath}).Validate())
+	require.Error(t, (&KeystoreSignerConfig{Path: keyPath, PasswordFile: passwordPath, PasswordEnv: "TEST_KEYSTORE_PASSWORD"}).Validate())
+
+	t.Setenv("TEST_KEYSTORE_PASSWORD", "wrong")
+	_, err = (&KeystoreSignerConfig{Path: keyPath, PasswordEnv: "TEST_KEYSTORE_PASSWORD"}).Build()
+	require.Error(t, err)
+}
@@ -0,0 +1,162 @@
+package ethereum
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/hyperledger-labs/yui-relayer/log"
+)
+
+const (
+	L2FeeModelOPStack  = "op_stack"
+	L2FeeModelArbitrum = "arbitrum"
+)
+
+var (
+	// GasPriceOracle predeploy of OP-stack chains
+	opStackGasPriceOracleAddress = common.HexToAddress("0x420000000000000000000000000000000000000F")
+	opStackGasPriceOracleABI     = mustParseABI(`[{"type":"function","name":"getL1Fee","stateMutability":"view","inputs":[{"name":"_data","type":"bytes"}],"outputs":[{"name":"","type":"uint256"}]}]`)
+
+	// NodeInterface of Arbitrum chains, which is only available via eth_call
+	arbitrumNodeInterfaceAddress = common.HexToAddress("0x00000000000000000000000000000000000000C8")
+	arbitrumNodeInterfaceABI     = mustParseABI(`[{"type":"function","name":"gasEstimateComponents","stateMutability":"payable","inputs":[{"name":"to","type":"address"},{"name":"contractCreation","type":"bool"},{"name":"data","type":"bytes"}],"outputs":[{"name":"gasEstimate","type":"uint64"},{"name":"gasEstimateForL1","type":"uint64"},{"name":"baseFee","type":"uint256"},{"name":"l1BaseFeeEstimate","type":"uint256"}]}]`)
+)
+
+func mustParseABI(s string) abi.ABI {
+	parsed, err := abi.JSON(strings.NewReader(s))
+	if err != nil {
+		panic(err)
+	}
+	return parsed
+}
+
+// L2FeeModel estimates the L1 data fee of txs on L2 chains, which is not reflected in eth_estimateGas on some chains
+type L2FeeModel interface {
+	// EstimateL1Fee returns the L1 data fee of the tx sent from `from` in wei
+	EstimateL1Fee(ctx context.Context, tx *gethtypes.Transaction, from common.Address) (*big.Int, error)
+	// ChargedInL2Gas returns true if the L1 data fee is paid as a part of the gas used by the tx,
+	// which means that the gas limit and the receipt already include it
+	ChargedInL2Gas() bool
+}
+
+// NewL2FeeModel returns the L2FeeModel selected by `l2_fee_model` in the config, or nil if it is empty
+func NewL2FeeModel(caller bind.ContractCaller, config *ChainConfig) L2FeeModel {
+	switch config.L2FeeModel {
+	case L2FeeModelOPStack:
+		return &OPStackFeeModel{caller: caller}
+	case L2FeeModelArbitrum:
+		return &ArbitrumFeeModel{caller: caller}
+	default:
+		return nil
+	}
+}
+
+// OPStackFeeModel uses `getL1Fee` of the GasPriceOracle predeploy. The L1 data fee is charged in addition to the L2 gas fee.
+type OPStackFeeModel struct {
+	caller bind.ContractCaller
+}
+
+var _ L2FeeModel = (*OPStackFeeModel)(nil)
+
+func (m *OPStackFeeModel) EstimateL1Fee(ctx context.Context, tx *gethtypes.Transaction, from common.Address) (*big.Int, error) {
+	// the fee of an unsigned tx is slightly lower than the signed one because the signature is not included
+	rawTx, err := tx.MarshalBinary()
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode tx: %v", err)
+	}
+	callData, err := opStackGasPriceOracleABI.Pack("getL1Fee", rawTx)
+	if err != nil {
+		return nil, err
+	}
+	ret, err := m.caller.CallContract(ctx, ethereum.CallMsg{To: &opStackGasPriceOracleAddress, Data: callData}, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to call getL1Fee: %v", err)
+	}
+	outputs, err := opStackGasPriceOracleABI.Unpack("getL1Fee", ret)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unpack getL1Fee: %v", err)
+	}
+	return outputs[0].(*big.Int), nil
+}
+
+func (m *OPStackFeeModel) ChargedInL2Gas() bool {
+	return false
+}
+
+// ArbitrumFeeModel uses `gasEstimateComponents` of the NodeInterface. The L1 data fee is charged as L2 gas,
+// so it is the L1 part of the gas estimate multiplied by the base fee.
+type ArbitrumFeeModel struct {
+	caller bind.ContractCaller
+}
+
+var _ L2FeeModel = (*ArbitrumFeeModel)(nil)
+
+func (m *ArbitrumFeeModel) EstimateL1Fee(ctx context.Context, tx *gethtypes.Transaction, from common.Address) (*big.Int, error) {
+	if tx.To() == nil {
+		return nil, fmt.Errorf("contract creation is not supported")
+	}
+	callData, err := arbitrumNodeInterfaceABI.Pack("gasEstimateComponents", *tx.To(), false, tx.Data())
+	if err != nil {
+		return nil, err
+	}
+	ret, err := m.caller.CallContract(ctx, ethereum.CallMsg{From: from, To: &arbitrumNodeInterfaceAddress, Value: tx.Value(), Data: callData}, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to call gasEstimateComponents: %v", err)
+	}
+	outputs, err := arbitrumNodeInterfaceABI.Unpack("gasEstimateComponents", ret)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unpack gasEstimateComponents: %v", err)
+	}
+	gasEstimateForL1, baseFee := outputs[1].(uint64), outputs[2].(*big.Int)
+	return new(big.Int).Mul(new(big.Int).SetUint64(gasEstimateForL1), baseFee), nil
+}
+
+func (m *ArbitrumFeeModel) ChargedInL2Gas() bool {
+	return true
+}
+
+// estimateL1Fee returns the L1 data fee of the tx, or nil if `l2_fee_model` is not configured.
+// If the fee exceeds `max_l1_fee`, an error is returned unless doRound is true in the same way as `max_gas_limit`.
+func estimateL1Fee(
+	ctx context.Context,
+	c *Chain,
+	tx *gethtypes.Transaction,
+	doRound bool, // only warn when the fee is over
+	logger *log.RelayLogger,
+) (*big.Int, error) {
+	if c.l2FeeModel == nil {
+		return nil, nil
+	}
+	l1Fee, err := c.l2FeeModel.EstimateL1Fee(ctx, tx, c.signerFor(ctx).Address())
+	if err != nil {
+		logger.ErrorContext(ctx, "failed to estimate L1 fee", err)
+		return nil, err
+	}
+	if maxL1Fee := c.Config().GetMaxL1Fee(); maxL1Fee.Sign() > 0 && l1Fee.Cmp(maxL1Fee) > 0 {
+		if !doRound {
+			return nil, fmt.Errorf("estimated L1 fee exceeds max L1 fee")
+		}
+		logger.WarnContext(ctx, "estimated L1 fee exceeds max L1 fee",
+			logAttrL1Fee, l1Fee.String(),
+			logAttrMaxL1Fee, maxL1Fee.String(),
+		)
+	}
+	return l1Fee, nil
+}
+
+// recordL1Fee records the L1 data fee of the tx in the fee budget if it is not included in the gas used
+func (c *Chain) recordL1Fee(ctx context.Context, l1Fee *big.Int) {
+	if l1Fee != nil && !c.l2FeeModel.ChargedInL2Gas() {
+		if err := c.feeBudget.Record(time.Now(), l1Fee); err != nil {
+			c.GetChainLogger().WarnContext(ctx, "failed to record L1 fee", "error", err)
+		}
+	}
+}
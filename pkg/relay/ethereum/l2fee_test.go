@@ -0,0 +1,102 @@
+package ethereum
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/hyperledger-labs/yui-relayer/log"
+	"github.com/stretchr/testify/require"
+)
+
+// l2FeeCaller is a fake bind.ContractCaller that answers the calls to the L2 fee predeploys
+type l2FeeCaller struct {
+	t     *testing.T
+	calls []ethereum.CallMsg
+}
+
+func (c *l2FeeCaller) CodeAt(ctx context.Context, contract common.Address, blockNumber *big.Int) ([]byte, error) {
+	return []byte{1}, nil
+}
+
+func (c *l2FeeCaller) CallContract(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	c.calls = append(c.calls, call)
+	switch *call.To {
+	case opStackGasPriceOracleAddress:
+		args, err := opStackGasPriceOracleABI.Methods["getL1Fee"].Inputs.Unpack(call.Data[4:])
+		require.NoError(c.t, err)
+		// 10 wei per byte of the tx
+		return opStackGasPriceOracleABI.Methods["getL1Fee"].Outputs.Pack(big.NewInt(int64(10 * len(args[0].([]byte)))))
+	case arbitrumNodeInterfaceAddress:
+		return arbitrumNodeInterfaceABI.Methods["gasEstimateComponents"].Outputs.Pack(uint64(150000), uint64(50000), big.NewInt(100), big.NewInt(30))
+	default:
+		c.t.Fatalf("unexpected call: %v", call.To)
+		return nil, nil
+	}
+}
+
+func TestL2FeeModel(t *testing.T) {
+	ctx := context.Background()
+	caller := &l2FeeCaller{t: t}
+	to := common.HexToAddress("0x01")
+	from := common.HexToAddress("0x02")
+	tx := gethtypes.NewTx(&gethtypes.DynamicFeeTx{Gas: 100000, To: &to, Data: []byte{1, 2, 3, 4}})
+	rawTx, err := tx.MarshalBinary()
+	require.NoError(t, err)
+
+	require.Nil(t, NewL2FeeModel(caller, &ChainConfig{}))
+
+	model := NewL2FeeModel(caller, &ChainConfig{L2FeeModel: L2FeeModelOPStack})
+	l1Fee, err := model.EstimateL1Fee(ctx, tx, from)
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(int64(10*len(rawTx))), l1Fee)
+	require.False(t, model.ChargedInL2Gas())
+
+	// the L1 part of the gas estimate is paid at the L2 base fee
+	model = NewL2FeeModel(caller, &ChainConfig{L2FeeModel: L2FeeModelArbitrum})
+	l1Fee, err = model.EstimateL1Fee(ctx, tx, from)
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(50000*100), l1Fee)
+	require.True(t, model.ChargedInL2Gas())
+	require.Equal(t, from, caller.calls[1].From)
+}
+
+func TestEstimateL1Fee(t *testing.T) {
+	if err := log.InitLogger("DEBUG", "text", "null", false); err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	c := newTestChain(t)
+	logger := c.GetChainLogger()
+	to := common.HexToAddress("0x01")
+	tx := gethtypes.NewTx(&gethtypes.DynamicFeeTx{Gas: 100000, To: &to})
+
+	// nothing is estimated without the fee model
+	l1Fee, err := estimateL1Fee(ctx, c, tx, false, logger)
+	require.NoError(t, err)
+	require.Nil(t, l1Fee)
+
+	c.config.L2FeeModel = L2FeeModelArbitrum
+	c.config.MaxL1Fee = "4000000wei"
+	c.l2FeeModel = NewL2FeeModel(&l2FeeCaller{t: t}, &c.config)
+
+	// batches over the limit are rejected, and single msgs are sent with a warning
+	_, err = estimateL1Fee(ctx, c, tx, false, logger)
+	require.Error(t, err)
+	l1Fee, err = estimateL1Fee(ctx, c, tx, true, logger)
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(5000000), l1Fee)
+
+	// the L1 fee is recorded in the budget only if it is charged separately from the L2 gas
+	c.feeBudget = newFeeBudget(&FeeBudgetConfig{HourlyLimit: "6000000wei"})
+	c.recordL1Fee(ctx, l1Fee)
+	require.NoError(t, c.feeBudget.Check(time.Now()))
+	c.l2FeeModel = &OPStackFeeModel{}
+	c.recordL1Fee(ctx, l1Fee)
+	c.recordL1Fee(ctx, l1Fee)
+	require.ErrorIs(t, c.feeBudget.Check(time.Now()), ErrFeeBudgetExceeded)
+}
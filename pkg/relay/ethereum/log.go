@@ -1,6 +1,7 @@
 package ethereum
 
 const (
+	logAttrMsgIndex        = "msg_index"
 	logAttrMsgIndexFrom    = "msg_index_from"
 	logAttrMsgCount        = "msg_count"
 	logAttrMsgType         = "msg_type"
@@ -22,4 +23,18 @@ const (
 	logAttrVersion         = "version"
 	logAttrImplementation  = "implementation"
 	logAttrInitialCalldata = "initial_calldata"
+	logAttrDenom           = "denom"
+	logAttrAmount          = "amount"
+	logAttrReceiver        = "receiver"
+	logAttrAllowance       = "allowance"
+	logAttrTreasury        = "treasury"
+	logAttrBalance         = "balance"
+	logAttrSigner          = "signer"
+	logAttrOldSigner       = "old_signer"
+	logAttrBlocksToTimeout = "blocks_to_timeout"
+	logAttrL1Fee           = "l1_fee"
+	logAttrMaxL1Fee        = "max_l1_fee"
+
+	logAttrEstimatedGasWithAccessList = "estimated_gas_with_access_list"
+	logAttrAccessListStorageKeys      = "access_list_storage_keys"
 )
@@ -21,11 +21,21 @@ func NewMsgID(txHash common.Hash) *MsgID {
 	}
 }
 
+// NewNoopMsgID returns a MsgID for a msg that was not sent because it had already been processed on the chain
+func NewNoopMsgID() *MsgID {
+	return NewMsgID(common.Hash{})
+}
+
 func (*MsgID) Is_MsgID() {}
 func (id *MsgID) TxHash() common.Hash {
 	return common.HexToHash(id.TxHashHex)
 }
 
+// IsNoop returns true if the msg corresponding to the MsgID was not sent
+func (id *MsgID) IsNoop() bool {
+	return id.TxHash() == common.Hash{}
+}
+
 type MsgResult struct {
 	height       clienttypes.Height
 	status       bool
@@ -134,6 +144,14 @@ func (c *Chain) parseMsgEventLogs(logs []*types.Log) ([]core.MsgEventLog, error)
 				TimeoutHeight:    clienttypes.Height(ev.Packet.TimeoutHeight),
 				TimeoutTimestamp: time.Unix(0, int64(ev.Packet.TimeoutTimestamp)),
 			}
+		case abiTimeoutPacket.ID:
+			ev, err := c.ibcHandler.ParseTimeoutPacket(*log)
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse TimeoutPacket event: logIndex=%d, log=%v", i, log)
+			}
+			// NOTE: yui-relayer has no event type corresponding to TimeoutPacket,
+			// which is emitted by both timeoutPacket and timeoutOnClose
+			event = &core.EventUnknown{Value: ev}
 		case abiChannelUpgradeOpen.ID:
 			ev, err := c.ibcHandler.ParseChannelUpgradeOpen(*log)
 			if err != nil {
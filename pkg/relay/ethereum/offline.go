@@ -0,0 +1,146 @@
+package ethereum
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/hyperledger-labs/yui-relayer/log"
+)
+
+// generateOnly holds the state of a command run with `--generate-only`.
+// Txs built with a context bound to it are neither signed nor sent, and are written to `out` as JSON instead.
+type generateOnly struct {
+	out io.Writer
+	// the sender of the txs, which defaults to the relayer account bound to the context
+	from common.Address
+	// nonce of the next tx, which is nil until the first tx is generated
+	nextNonce *uint64
+}
+
+type generateOnlyContextKey struct{}
+
+// contextWithGenerateOnly returns a context that makes txs built with it written to `g.out` instead of being sent
+func contextWithGenerateOnly(ctx context.Context, g *generateOnly) context.Context {
+	return context.WithValue(ctx, generateOnlyContextKey{}, g)
+}
+
+// generateOnlyFrom returns the generate-only state bound to the context, or nil if txs are sent as usual
+func generateOnlyFrom(ctx context.Context) *generateOnly {
+	g, _ := ctx.Value(generateOnlyContextKey{}).(*generateOnly)
+	return g
+}
+
+// generateOnlyTxOpts returns tx opts that build unsigned txs without sending them.
+// Consecutive txs get consecutive nonces because none of them reaches the chain.
+func (c *Chain) generateOnlyTxOpts(ctx context.Context, g *generateOnly) (*bind.TransactOpts, error) {
+	if g.from == (common.Address{}) {
+		g.from = c.signerFor(ctx).Address()
+	}
+	from := g.from
+
+	txOpts := &bind.TransactOpts{
+		From: from,
+		Signer: func(_ common.Address, tx *gethtypes.Transaction) (*gethtypes.Transaction, error) {
+			return tx, nil
+		},
+		Context: ctx,
+		NoSend:  true,
+	}
+
+	if g.nextNonce != nil {
+		txOpts.Nonce = new(big.Int).SetUint64(*g.nextNonce)
+	} else if nonce, err := c.client.NonceAt(ctx, from, nil); err != nil {
+		return nil, err
+	} else {
+		txOpts.Nonce = new(big.Int).SetUint64(nonce)
+	}
+
+	if err := NewGasFeeCalculator(c.client, &c.config).Apply(ctx, txOpts); err != nil {
+		return nil, err
+	}
+
+	return txOpts, nil
+}
+
+// writeUnsignedTx writes the unsigned tx built with `generateOnlyTxOpts` as JSON
+func (c *Chain) writeUnsignedTx(g *generateOnly, tx *gethtypes.Transaction) error {
+	args, err := newTxArgs(g.from, tx, c.chainID)
+	if err != nil {
+		return err
+	}
+	bz, err := json.MarshalIndent(args, "", "  ")
+	if err != nil {
+		return err
+	}
+	if _, err := fmt.Fprintf(g.out, "%s\n", bz); err != nil {
+		return err
+	}
+	nextNonce := tx.Nonce() + 1
+	g.nextNonce = &nextNonce
+	return nil
+}
+
+// SignUnsignedTx signs the unsigned tx generated with `--generate-only` by the relayer or treasury account that is its sender
+func (c *Chain) SignUnsignedTx(ctx context.Context, args *txArgs) (*gethtypes.Transaction, error) {
+	signers := c.signers
+	if c.treasurySigner != nil {
+		signers = append(append([]*EthereumSigner{}, c.signers...), c.treasurySigner)
+	}
+	return c.signUnsignedTx(ctx, args, signers)
+}
+
+// SignUnsignedTxWithSignerConfig signs the unsigned tx by the account specified by the signer config
+// (in the same format as `signer` in the chain config), which doesn't have to be configured for the chain.
+// The tx is recorded in the audit log, but tx_policy is not applied because the account is not a relayer account.
+func (c *Chain) SignUnsignedTxWithSignerConfig(ctx context.Context, args *txArgs, signerConfig []byte) (*gethtypes.Transaction, error) {
+	config, err := c.decodeSignerConfig(signerConfig)
+	if err != nil {
+		return nil, err
+	}
+	signers, err := buildEthereumSigners(ctx, config, c.chainID)
+	if err != nil {
+		return nil, err
+	}
+	for _, s := range signers {
+		s.auditLog = c.ethereumSigner.auditLog
+	}
+	return c.signUnsignedTx(ctx, args, signers)
+}
+
+func (c *Chain) signUnsignedTx(ctx context.Context, args *txArgs, signers []*EthereumSigner) (*gethtypes.Transaction, error) {
+	if err := args.validate(); err != nil {
+		return nil, fmt.Errorf("invalid unsigned tx: %v", err)
+	}
+	if args.ChainID.ToInt().Cmp(c.chainID) != 0 {
+		return nil, fmt.Errorf("chain ID mismatch: expected=%v, actual=%v", c.chainID, args.ChainID.ToInt())
+	}
+	for _, s := range signers {
+		if s.Address() == args.From {
+			return s.SignWithOptions(ctx, SignOptions{Logger: c.GetChainLogger(), Path: c.pathEnd}, args.From, args.toTransaction())
+		}
+	}
+	return nil, fmt.Errorf("no signer for the sender: %v", args.From)
+}
+
+// BroadcastTx sends the signed tx and waits for its receipt in the same way as the other admin txs
+func (c *Chain) BroadcastTx(ctx context.Context, rawTx []byte) error {
+	tx := new(gethtypes.Transaction)
+	if err := tx.UnmarshalBinary(rawTx); err != nil {
+		return fmt.Errorf("failed to decode signed tx: %v", err)
+	}
+	if tx.ChainId().Cmp(c.chainID) != 0 {
+		return fmt.Errorf("chain ID mismatch: expected=%v, actual=%v", c.chainID, tx.ChainId())
+	}
+
+	logger := c.GetChainLogger()
+	logger = &log.RelayLogger{Logger: logger.With(logAttrTxHash, tx.Hash())}
+
+	err := c.client.SendTransaction(ctx, tx)
+	return processSendTxResult(ctx, logger, c, tx, err)
+}
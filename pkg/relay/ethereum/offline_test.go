@@ -0,0 +1,101 @@
+package ethereum
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/hyperledger-labs/yui-relayer/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/datachainlab/ethereum-ibc-relay-chain/pkg/contract/ibchandler"
+)
+
+// broadcastTestEthService accepts raw txs and returns successful receipts for them
+type broadcastTestEthService struct {
+	testEthService
+	sent []*gethtypes.Transaction
+}
+
+func (s *broadcastTestEthService) SendRawTransaction(rawTx hexutil.Bytes) (common.Hash, error) {
+	tx := new(gethtypes.Transaction)
+	if err := tx.UnmarshalBinary(rawTx); err != nil {
+		return common.Hash{}, err
+	}
+	s.sent = append(s.sent, tx)
+	return tx.Hash(), nil
+}
+
+func (s *broadcastTestEthService) GetTransactionReceipt(txHash common.Hash) (*gethtypes.Receipt, error) {
+	return &gethtypes.Receipt{
+		Status:  gethtypes.ReceiptStatusSuccessful,
+		TxHash:  txHash,
+		GasUsed: 21000,
+		Logs:    []*gethtypes.Log{},
+	}, nil
+}
+
+func TestGenerateOnly(t *testing.T) {
+	if err := log.InitLogger("DEBUG", "text", "null", false); err != nil {
+		t.Fatal(err)
+	}
+	c := newTestChain(t)
+	ibcHandlerABI, err := ibchandler.IbchandlerMetaData.GetAbi()
+	require.NoError(t, err)
+
+	// consecutive txs get consecutive nonces
+	var out bytes.Buffer
+	ctx := contextWithGenerateOnly(context.Background(), &generateOnly{out: &out})
+	require.NoError(t, c.CloseChannel(ctx, "transfer", "channel-0"))
+	require.NoError(t, c.CloseChannel(ctx, "transfer", "channel-1"))
+
+	dec := json.NewDecoder(&out)
+	var unsignedTxs []txArgs
+	for dec.More() {
+		var args txArgs
+		require.NoError(t, dec.Decode(&args))
+		unsignedTxs = append(unsignedTxs, args)
+	}
+	require.Len(t, unsignedTxs, 2)
+	for i, args := range unsignedTxs {
+		require.Equal(t, c.ethereumSigner.Address(), args.From)
+		require.Equal(t, common.HexToAddress("0x01"), *args.To)
+		require.Equal(t, uint64(1+i), uint64(args.Nonce))
+		require.Equal(t, uint64(100_000), uint64(args.Gas))
+		require.NotNil(t, args.GasPrice)
+		method, err := ibcHandlerABI.MethodById(args.Data[:4])
+		require.NoError(t, err)
+		require.Equal(t, "channelCloseInit", method.Name)
+	}
+
+	// the unsigned tx is signed offline and broadcast
+	signed, err := c.SignUnsignedTx(context.Background(), &unsignedTxs[0])
+	require.NoError(t, err)
+	sender, err := gethtypes.Sender(c.ethereumSigner.gethSigner, signed)
+	require.NoError(t, err)
+	require.Equal(t, c.ethereumSigner.Address(), sender)
+	require.Equal(t, c.ethereumSigner.gethSigner.Hash(unsignedTxs[0].toTransaction()), c.ethereumSigner.gethSigner.Hash(signed))
+
+	service := &broadcastTestEthService{}
+	withTestEthService(t, c, service)
+
+	rawTx, err := signed.MarshalBinary()
+	require.NoError(t, err)
+	require.NoError(t, c.BroadcastTx(context.Background(), rawTx))
+	require.Len(t, service.sent, 1)
+	require.Equal(t, signed.Hash(), service.sent[0].Hash())
+
+	// unknown senders and other chains are rejected
+	unknown := unsignedTxs[1]
+	unknown.From = common.HexToAddress("0x03")
+	_, err = c.SignUnsignedTx(context.Background(), &unknown)
+	require.Error(t, err)
+	otherChain := unsignedTxs[1]
+	otherChain.ChainID = (*hexutil.Big)(common.Big2)
+	_, err = c.SignUnsignedTx(context.Background(), &otherChain)
+	require.Error(t, err)
+}
@@ -0,0 +1,206 @@
+package ethereum
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/datachainlab/ethereum-ibc-relay-chain/pkg/contract/ibchandler"
+	"github.com/datachainlab/ethereum-ibc-relay-chain/pkg/contract/multicall3"
+	"github.com/datachainlab/ethereum-ibc-relay-chain/pkg/utils"
+)
+
+// relayIBCHandlerMethods are the methods of the IBC handler that the relayer calls to relay msgs.
+// The admin methods such as registerClient and bindPort are not included.
+var relayIBCHandlerMethods = []string{
+	"createClient",
+	"updateClient",
+	"updateClientCommitments",
+	"connectionOpenInit",
+	"connectionOpenTry",
+	"connectionOpenAck",
+	"connectionOpenConfirm",
+	"channelOpenInit",
+	"channelOpenTry",
+	"channelOpenAck",
+	"channelOpenConfirm",
+	"channelCloseInit",
+	"channelCloseConfirm",
+	"recvPacket",
+	"acknowledgePacket",
+	"timeoutPacket",
+	"timeoutOnClose",
+	"channelUpgradeInit",
+	"channelUpgradeTry",
+	"channelUpgradeAck",
+	"channelUpgradeConfirm",
+	"channelUpgradeOpen",
+	"cancelChannelUpgrade",
+	"timeoutChannelUpgrade",
+}
+
+// TxPolicy checks txs before they are signed by the relayer accounts
+type TxPolicy struct {
+	ibcHandler       common.Address
+	multicall3       common.Address
+	allowLCFunctions *AllowLCFunctions
+
+	allowedTo map[common.Address]bool
+	// nil means that any function is allowed for `allowedTo`
+	allowedSelectors map[[4]byte]bool
+
+	maxValue *big.Int
+	// nil means no limit
+	maxGasPrice *big.Int
+	// nil means no limit
+	maxFee *big.Int
+
+	// selectors of `relayIBCHandlerMethods`
+	ibcHandlerSelectors map[[4]byte]bool
+	multicall3ABI       *abi.ABI
+}
+
+// CONTRACT: config.TxPolicy.ValidateBasic() must be called before calling this function.
+func NewTxPolicy(config ChainConfig, alf *AllowLCFunctions) (*TxPolicy, error) {
+	pc := config.TxPolicy
+	ibcHandlerABI, err := ibchandler.IbchandlerMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	multicall3ABI, err := multicall3.Multicall3MetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+
+	p := &TxPolicy{
+		ibcHandler:       config.IBCAddress(),
+		multicall3:       config.Multicall3AddressAsAddress(),
+		allowLCFunctions: alf,
+		allowedTo:        make(map[common.Address]bool),
+		maxValue:         new(big.Int),
+		multicall3ABI:    multicall3ABI,
+	}
+	p.ibcHandlerSelectors = make(map[[4]byte]bool)
+	for _, name := range relayIBCHandlerMethods {
+		method, ok := ibcHandlerABI.Methods[name]
+		if !ok {
+			return nil, fmt.Errorf("method not found in the IBC handler ABI: %v", name)
+		}
+		p.ibcHandlerSelectors[[4]byte(method.ID)] = true
+	}
+	for _, addr := range pc.AllowedToAddresses {
+		p.allowedTo[common.HexToAddress(addr)] = true
+	}
+	if len(pc.AllowedSelectors) > 0 {
+		p.allowedSelectors = make(map[[4]byte]bool)
+		for _, s := range pc.AllowedSelectors {
+			sel, err := parseSelector(s)
+			if err != nil {
+				return nil, err
+			}
+			p.allowedSelectors[sel] = true
+		}
+	}
+	if pc.MaxValue != "" {
+		if p.maxValue, err = utils.ParseEtherAmount(pc.MaxValue); err != nil {
+			return nil, err
+		}
+	}
+	if pc.MaxGasPrice != "" {
+		if p.maxGasPrice, err = utils.ParseEtherAmount(pc.MaxGasPrice); err != nil {
+			return nil, err
+		}
+	}
+	if pc.MaxFee != "" {
+		if p.maxFee, err = utils.ParseEtherAmount(pc.MaxFee); err != nil {
+			return nil, err
+		}
+	}
+	return p, nil
+}
+
+// Check returns an error wrapping ErrTxPolicyViolation if the tx violates the policy
+func (p *TxPolicy) Check(tx *gethtypes.Transaction) error {
+	if tx.To() == nil {
+		return fmt.Errorf("%w: contract creation is not allowed", ErrTxPolicyViolation)
+	}
+	if tx.Value().Cmp(p.maxValue) > 0 {
+		return fmt.Errorf("%w: value exceeds the limit: value=%v, max=%v", ErrTxPolicyViolation, tx.Value(), p.maxValue)
+	}
+	// GasFeeCap returns the gas price for legacy txs
+	if p.maxGasPrice != nil && tx.GasFeeCap().Cmp(p.maxGasPrice) > 0 {
+		return fmt.Errorf("%w: gas price exceeds the limit: gas_price=%v, max=%v", ErrTxPolicyViolation, tx.GasFeeCap(), p.maxGasPrice)
+	}
+	if p.maxFee != nil {
+		fee := new(big.Int).Mul(new(big.Int).SetUint64(tx.Gas()), tx.GasFeeCap())
+		if fee.Cmp(p.maxFee) > 0 {
+			return fmt.Errorf("%w: fee exceeds the limit: fee=%v, max=%v", ErrTxPolicyViolation, fee, p.maxFee)
+		}
+	}
+	return p.checkCall(*tx.To(), tx.Data(), true)
+}
+
+// checkCall checks the destination and the function of a call. If `allowMulticall` is true,
+// calls to multicall3 are allowed and each call aggregated in it is checked.
+func (p *TxPolicy) checkCall(to common.Address, data []byte, allowMulticall bool) error {
+	var sel [4]byte
+	hasSelector := len(data) >= 4
+	if hasSelector {
+		copy(sel[:], data[:4])
+	}
+
+	switch {
+	case to == p.ibcHandler:
+		if hasSelector && p.ibcHandlerSelectors[sel] {
+			return nil
+		}
+	case allowMulticall && (p.multicall3 != common.Address{}) && to == p.multicall3:
+		if hasSelector {
+			return p.checkMulticall(sel, data)
+		}
+	case p.allowLCFunctions != nil && hasSelector && p.allowLCFunctions.IsAllowed(to, sel):
+		return nil
+	case p.allowedTo[to]:
+		if p.allowedSelectors == nil || (hasSelector && p.allowedSelectors[sel]) {
+			return nil
+		}
+	default:
+		return fmt.Errorf("%w: destination is not allowed: to=%v", ErrTxPolicyViolation, to)
+	}
+	return fmt.Errorf("%w: function is not allowed: to=%v, selector=0x%x", ErrTxPolicyViolation, to, data[:min(4, len(data))])
+}
+
+func (p *TxPolicy) checkMulticall(sel [4]byte, data []byte) error {
+	method, err := p.multicall3ABI.MethodById(sel[:])
+	if err != nil || method.Name != "aggregate" {
+		return fmt.Errorf("%w: function is not allowed: to=%v, selector=0x%x", ErrTxPolicyViolation, p.multicall3, sel)
+	}
+	args, err := method.Inputs.Unpack(data[4:])
+	if err != nil {
+		return fmt.Errorf("%w: failed to decode multicall: %v", ErrTxPolicyViolation, err)
+	}
+	calls := *abi.ConvertType(args[0], new([]multicall3.Multicall3Call)).(*[]multicall3.Multicall3Call)
+	for i, call := range calls {
+		if err := p.checkCall(call.Target, call.CallData, false); err != nil {
+			return fmt.Errorf("call %d in multicall: %w", i, err)
+		}
+	}
+	return nil
+}
+
+func parseSelector(s string) ([4]byte, error) {
+	var sel [4]byte
+	bz, err := hex.DecodeString(strings.TrimPrefix(s, "0x"))
+	if err != nil {
+		return sel, fmt.Errorf("failed to decode selector: selector=%v err=%v", s, err)
+	} else if len(bz) != 4 {
+		return sel, fmt.Errorf("invalid selector length: selector=%v", s)
+	}
+	copy(sel[:], bz)
+	return sel, nil
+}
@@ -0,0 +1,98 @@
+package ethereum
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/datachainlab/ethereum-ibc-relay-chain/pkg/contract/ibchandler"
+	"github.com/datachainlab/ethereum-ibc-relay-chain/pkg/contract/multicall3"
+)
+
+func TestTxPolicy(t *testing.T) {
+	ibcHandlerAddr := common.HexToAddress("0x01")
+	multicall3Addr := common.HexToAddress("0x02")
+	lcAddr := common.HexToAddress("0x03")
+	appAddr := common.HexToAddress("0x04")
+	unknownAddr := common.HexToAddress("0x05")
+
+	policy, err := NewTxPolicy(ChainConfig{
+		IbcAddress:        ibcHandlerAddr.Hex(),
+		Multicall3Address: multicall3Addr.Hex(),
+		TxPolicy: &TxPolicyConfig{
+			AllowedToAddresses: []string{appAddr.Hex()},
+			AllowedSelectors:   []string{"0xaabbccdd"},
+			MaxValue:           "0wei",
+			MaxGasPrice:        "100gwei",
+			MaxFee:             "10000000gwei",
+		},
+	}, &AllowLCFunctions{LCAddress: lcAddr, Selectors: [][4]byte{{0x11, 0x22, 0x33, 0x44}}})
+	require.NoError(t, err)
+
+	ibcHandlerABI, err := ibchandler.IbchandlerMetaData.GetAbi()
+	require.NoError(t, err)
+	recvPacket := ibcHandlerABI.Methods["recvPacket"].ID
+	multicall3ABI, err := multicall3.Multicall3MetaData.GetAbi()
+	require.NoError(t, err)
+	aggregate := func(calls ...multicall3.Multicall3Call) []byte {
+		data, err := multicall3ABI.Pack("aggregate", calls)
+		require.NoError(t, err)
+		return data
+	}
+
+	newTx := func(to *common.Address, data []byte, value int64, gasPrice int64, gas uint64) *gethtypes.Transaction {
+		return gethtypes.NewTx(&gethtypes.LegacyTx{
+			To:       to,
+			Data:     data,
+			Value:    big.NewInt(value),
+			GasPrice: big.NewInt(gasPrice),
+			Gas:      gas,
+		})
+	}
+	const gwei = 1_000_000_000
+
+	var cases = []struct {
+		name    string
+		tx      *gethtypes.Transaction
+		allowed bool
+	}{
+		{"ibc handler", newTx(&ibcHandlerAddr, recvPacket, 0, gwei, 100_000), true},
+		{"unknown ibc handler function", newTx(&ibcHandlerAddr, []byte{0xaa, 0xbb, 0xcc, 0xdd}, 0, gwei, 100_000), false},
+		{"ibc handler admin function", newTx(&ibcHandlerAddr, ibcHandlerABI.Methods["registerClient"].ID, 0, gwei, 100_000), false},
+		{"ibc handler port binding", newTx(&ibcHandlerAddr, ibcHandlerABI.Methods["bindPort"].ID, 0, gwei, 100_000), false},
+		{"allowed lc function", newTx(&lcAddr, []byte{0x11, 0x22, 0x33, 0x44}, 0, gwei, 100_000), true},
+		{"not allowed lc function", newTx(&lcAddr, []byte{0xaa, 0xbb, 0xcc, 0xdd}, 0, gwei, 100_000), false},
+		{"allowed address and selector", newTx(&appAddr, []byte{0xaa, 0xbb, 0xcc, 0xdd}, 0, gwei, 100_000), true},
+		{"allowed address with not allowed selector", newTx(&appAddr, []byte{0x11, 0x22, 0x33, 0x44}, 0, gwei, 100_000), false},
+		{"unknown address", newTx(&unknownAddr, recvPacket, 0, gwei, 100_000), false},
+		{"contract creation", newTx(nil, recvPacket, 0, gwei, 100_000), false},
+		{"value", newTx(&ibcHandlerAddr, recvPacket, 1, gwei, 100_000), false},
+		{"gas price", newTx(&ibcHandlerAddr, recvPacket, 0, 101*gwei, 100_000), false},
+		{"fee", newTx(&ibcHandlerAddr, recvPacket, 0, 100*gwei, 100_001), false},
+		{"multicall", newTx(&multicall3Addr, aggregate(
+			multicall3.Multicall3Call{Target: ibcHandlerAddr, CallData: recvPacket},
+			multicall3.Multicall3Call{Target: lcAddr, CallData: []byte{0x11, 0x22, 0x33, 0x44}},
+		), 0, gwei, 100_000), true},
+		{"multicall with unknown address", newTx(&multicall3Addr, aggregate(
+			multicall3.Multicall3Call{Target: ibcHandlerAddr, CallData: recvPacket},
+			multicall3.Multicall3Call{Target: unknownAddr, CallData: recvPacket},
+		), 0, gwei, 100_000), false},
+		{"nested multicall", newTx(&multicall3Addr, aggregate(
+			multicall3.Multicall3Call{Target: multicall3Addr, CallData: aggregate()},
+		), 0, gwei, 100_000), false},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			err := policy.Check(c.tx)
+			if c.allowed {
+				require.NoError(t, err)
+			} else {
+				require.True(t, errors.Is(err, ErrTxPolicyViolation), err)
+			}
+		})
+	}
+}
@@ -0,0 +1,226 @@
+package ethereum
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
+	gethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/hyperledger-labs/yui-relayer/signer"
+)
+
+// TxSigner is implemented by signers that sign txs as a whole instead of their digests.
+// EthereumSigner uses SignTx instead of Sign if the underlying signer implements this interface.
+type TxSigner interface {
+	SignTx(ctx context.Context, from common.Address, tx *gethtypes.Transaction, chainID *big.Int) (*gethtypes.Transaction, error)
+}
+
+var _ signer.SignerConfig = (*RemoteSignerConfig)(nil)
+
+func (c *RemoteSignerConfig) Validate() error {
+	if strings.TrimSpace(c.RpcAddr) == "" {
+		return errors.New("config attribute \"rpc_addr\" is empty")
+	}
+	if !common.IsHexAddress(c.Address) {
+		return errors.New("config attribute \"address\" should be hex address")
+	}
+	return nil
+}
+
+func (c *RemoteSignerConfig) Build() (signer.Signer, error) {
+	client, err := rpc.DialContext(context.Background(), c.RpcAddr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to dial remote signer: %v", err)
+	}
+	return &RemoteSigner{
+		client:  client,
+		address: common.HexToAddress(c.Address),
+	}, nil
+}
+
+// RemoteSigner signs txs with a signing service via `eth_signTransaction` JSON-RPC
+type RemoteSigner struct {
+	client  *rpc.Client
+	address common.Address
+}
+
+var (
+	_ signer.Signer = (*RemoteSigner)(nil)
+	_ TxSigner      = (*RemoteSigner)(nil)
+)
+
+// Sign is not supported because the signing service never signs raw digests
+func (s *RemoteSigner) Sign(ctx context.Context, digest []byte) ([]byte, error) {
+	return nil, fmt.Errorf("%w: remote signer cannot sign digests", ErrNotSupported)
+}
+
+// GetPublicKey recovers the public key from a signature over a fixed message by `eth_sign`
+func (s *RemoteSigner) GetPublicKey(ctx context.Context) ([]byte, error) {
+	msg := []byte("ethereum-ibc-relay-chain remote signer")
+	var sig hexutil.Bytes
+	if err := s.client.CallContext(ctx, &sig, "eth_sign", s.address, hexutil.Bytes(msg)); err != nil {
+		return nil, fmt.Errorf("failed to call eth_sign: %w", err)
+	}
+	if len(sig) != gethcrypto.SignatureLength {
+		return nil, fmt.Errorf("invalid signature length: %d", len(sig))
+	}
+	// eth_sign returns V in {27, 28}
+	if sig[gethcrypto.RecoveryIDOffset] >= 27 {
+		sig[gethcrypto.RecoveryIDOffset] -= 27
+	}
+	pk, err := gethcrypto.SigToPub(accounts.TextHash(msg), sig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to recover public key: %w", err)
+	}
+	if addr := gethcrypto.PubkeyToAddress(*pk); addr != s.address {
+		return nil, fmt.Errorf("unexpected signer address: expected=%v, actual=%v", s.address, addr)
+	}
+	return gethcrypto.CompressPubkey(pk), nil
+}
+
+// txArgs is the JSON representation of an unsigned tx, which is the argument of `eth_signTransaction`
+// and the output of `--generate-only`
+type txArgs struct {
+	From                 common.Address        `json:"from"`
+	To                   *common.Address       `json:"to,omitempty"`
+	Gas                  hexutil.Uint64        `json:"gas"`
+	GasPrice             *hexutil.Big          `json:"gasPrice,omitempty"`
+	MaxFeePerGas         *hexutil.Big          `json:"maxFeePerGas,omitempty"`
+	MaxPriorityFeePerGas *hexutil.Big          `json:"maxPriorityFeePerGas,omitempty"`
+	Value                *hexutil.Big          `json:"value"`
+	Nonce                hexutil.Uint64        `json:"nonce"`
+	Data                 hexutil.Bytes         `json:"data"`
+	AccessList           *gethtypes.AccessList `json:"accessList,omitempty"`
+	ChainID              *hexutil.Big          `json:"chainId"`
+}
+
+func newTxArgs(from common.Address, tx *gethtypes.Transaction, chainID *big.Int) (*txArgs, error) {
+	args := &txArgs{
+		From:    from,
+		To:      tx.To(),
+		Gas:     hexutil.Uint64(tx.Gas()),
+		Value:   (*hexutil.Big)(tx.Value()),
+		Nonce:   hexutil.Uint64(tx.Nonce()),
+		Data:    tx.Data(),
+		ChainID: (*hexutil.Big)(chainID),
+	}
+	switch tx.Type() {
+	case gethtypes.LegacyTxType:
+		args.GasPrice = (*hexutil.Big)(tx.GasPrice())
+	case gethtypes.AccessListTxType:
+		args.GasPrice = (*hexutil.Big)(tx.GasPrice())
+		accessList := tx.AccessList()
+		args.AccessList = &accessList
+	case gethtypes.DynamicFeeTxType:
+		args.MaxFeePerGas = (*hexutil.Big)(tx.GasFeeCap())
+		args.MaxPriorityFeePerGas = (*hexutil.Big)(tx.GasTipCap())
+		accessList := tx.AccessList()
+		args.AccessList = &accessList
+	default:
+		return nil, fmt.Errorf("%w: %d", ErrUnsupportedTxType, tx.Type())
+	}
+	return args, nil
+}
+
+// toTransaction returns the unsigned tx. The tx type is determined in the same way as `eth_signTransaction`.
+func (args *txArgs) toTransaction() *gethtypes.Transaction {
+	value := new(big.Int)
+	if args.Value != nil {
+		value = args.Value.ToInt()
+	}
+	var accessList gethtypes.AccessList
+	if args.AccessList != nil {
+		accessList = *args.AccessList
+	}
+	switch {
+	case args.MaxFeePerGas != nil:
+		return gethtypes.NewTx(&gethtypes.DynamicFeeTx{
+			ChainID:    args.ChainID.ToInt(),
+			Nonce:      uint64(args.Nonce),
+			GasTipCap:  args.MaxPriorityFeePerGas.ToInt(),
+			GasFeeCap:  args.MaxFeePerGas.ToInt(),
+			Gas:        uint64(args.Gas),
+			To:         args.To,
+			Value:      value,
+			Data:       args.Data,
+			AccessList: accessList,
+		})
+	case args.AccessList != nil:
+		return gethtypes.NewTx(&gethtypes.AccessListTx{
+			ChainID:    args.ChainID.ToInt(),
+			Nonce:      uint64(args.Nonce),
+			GasPrice:   args.GasPrice.ToInt(),
+			Gas:        uint64(args.Gas),
+			To:         args.To,
+			Value:      value,
+			Data:       args.Data,
+			AccessList: accessList,
+		})
+	default:
+		return gethtypes.NewTx(&gethtypes.LegacyTx{
+			Nonce:    uint64(args.Nonce),
+			GasPrice: args.GasPrice.ToInt(),
+			Gas:      uint64(args.Gas),
+			To:       args.To,
+			Value:    value,
+			Data:     args.Data,
+		})
+	}
+}
+
+// validate checks that the fields required by the tx type are set
+func (args *txArgs) validate() error {
+	if args.ChainID == nil {
+		return errors.New("chainId is missing")
+	}
+	if args.MaxFeePerGas != nil {
+		if args.MaxPriorityFeePerGas == nil {
+			return errors.New("maxPriorityFeePerGas is missing")
+		}
+	} else if args.GasPrice == nil {
+		return errors.New("either gasPrice or maxFeePerGas must be set")
+	}
+	return nil
+}
+
+// SignTx sends the unsigned tx to the signing service and returns the signed tx.
+// EthereumSigner checks that the signed tx is the same as the unsigned one and is signed by `from`.
+func (s *RemoteSigner) SignTx(ctx context.Context, from common.Address, tx *gethtypes.Transaction, chainID *big.Int) (*gethtypes.Transaction, error) {
+	if from != s.address {
+		return nil, fmt.Errorf("unauthorized address: authorized=%v, given=%v", s.address, from)
+	}
+	args, err := newTxArgs(from, tx, chainID)
+	if err != nil {
+		return nil, err
+	}
+
+	var res json.RawMessage
+	if err := s.client.CallContext(ctx, &res, "eth_signTransaction", args); err != nil {
+		return nil, fmt.Errorf("failed to call eth_signTransaction: %w", err)
+	}
+	// Web3Signer returns the raw tx and Clef returns an object that contains it
+	var raw hexutil.Bytes
+	if err := json.Unmarshal(res, &raw); err != nil {
+		var obj struct {
+			Raw hexutil.Bytes `json:"raw"`
+		}
+		if err := json.Unmarshal(res, &obj); err != nil {
+			return nil, fmt.Errorf("unexpected result of eth_signTransaction: %s", res)
+		}
+		raw = obj.Raw
+	}
+
+	signed := new(gethtypes.Transaction)
+	if err := signed.UnmarshalBinary(raw); err != nil {
+		return nil, fmt.Errorf("failed to decode signed tx: %w", err)
+	}
+	return signed, nil
+}
@@ -0,0 +1,145 @@
+package ethereum
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"math/big"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
+	gethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/require"
+)
+
+// stubSignerService is a minimal signing service that serves `eth_sign` and `eth_signTransaction`
+type stubSignerService struct {
+	key *ecdsa.PrivateKey
+	// if set, the tx is modified before it is signed
+	tamper func(*gethtypes.DynamicFeeTx)
+}
+
+func (s *stubSignerService) Sign(addr common.Address, data hexutil.Bytes) (hexutil.Bytes, error) {
+	sig, err := gethcrypto.Sign(accounts.TextHash(data), s.key)
+	if err != nil {
+		return nil, err
+	}
+	sig[gethcrypto.RecoveryIDOffset] += 27
+	return sig, nil
+}
+
+func (s *stubSignerService) SignTransaction(args txArgs) (hexutil.Bytes, error) {
+	var txData gethtypes.TxData
+	if args.GasPrice != nil {
+		txData = &gethtypes.LegacyTx{
+			Nonce:    uint64(args.Nonce),
+			GasPrice: args.GasPrice.ToInt(),
+			Gas:      uint64(args.Gas),
+			To:       args.To,
+			Value:    args.Value.ToInt(),
+			Data:     args.Data,
+		}
+	} else {
+		dynamicTx := &gethtypes.DynamicFeeTx{
+			ChainID:   args.ChainID.ToInt(),
+			Nonce:     uint64(args.Nonce),
+			GasTipCap: args.MaxPriorityFeePerGas.ToInt(),
+			GasFeeCap: args.MaxFeePerGas.ToInt(),
+			Gas:       uint64(args.Gas),
+			To:        args.To,
+			Value:     args.Value.ToInt(),
+			Data:      args.Data,
+		}
+		if args.AccessList != nil {
+			dynamicTx.AccessList = *args.AccessList
+		}
+		if s.tamper != nil {
+			s.tamper(dynamicTx)
+		}
+		txData = dynamicTx
+	}
+	tx, err := gethtypes.SignNewTx(s.key, gethtypes.LatestSignerForChainID(args.ChainID.ToInt()), txData)
+	if err != nil {
+		return nil, err
+	}
+	return tx.MarshalBinary()
+}
+
+func newStubRemoteSigner(t *testing.T, service *stubSignerService, address common.Address) *RemoteSigner {
+	server := rpc.NewServer()
+	require.NoError(t, server.RegisterName("eth", service))
+	httpServer := httptest.NewServer(server)
+	t.Cleanup(func() {
+		httpServer.Close()
+		server.Stop()
+	})
+
+	config := &RemoteSignerConfig{RpcAddr: httpServer.URL, Address: address.Hex()}
+	require.NoError(t, config.Validate())
+	bytesSigner, err := config.Build()
+	require.NoError(t, err)
+	return bytesSigner.(*RemoteSigner)
+}
+
+func TestRemoteSigner(t *testing.T) {
+	ctx := context.Background()
+	chainID := big.NewInt(1)
+	key, err := gethcrypto.GenerateKey()
+	require.NoError(t, err)
+	addr := gethcrypto.PubkeyToAddress(key.PublicKey)
+	to := common.HexToAddress("0x01")
+
+	s, err := NewEthereumSigner(ctx, newStubRemoteSigner(t, &stubSignerService{key: key}, addr), chainID)
+	require.NoError(t, err)
+	require.Equal(t, addr, s.Address())
+
+	for _, txData := range []gethtypes.TxData{
+		&gethtypes.LegacyTx{Nonce: 1, GasPrice: big.NewInt(10), Gas: 21000, To: &to, Data: []byte{1, 2, 3, 4}},
+		&gethtypes.DynamicFeeTx{ChainID: chainID, Nonce: 2, GasTipCap: big.NewInt(1), GasFeeCap: big.NewInt(10), Gas: 21000, To: &to, Data: []byte{1, 2, 3, 4}},
+	} {
+		tx := gethtypes.NewTx(txData)
+		signed, err := s.Sign(addr, tx)
+		require.NoError(t, err)
+		sender, err := gethtypes.Sender(s.gethSigner, signed)
+		require.NoError(t, err)
+		require.Equal(t, addr, sender)
+		require.Equal(t, s.gethSigner.Hash(tx), s.gethSigner.Hash(signed))
+	}
+}
+
+func TestRemoteSignerVerification(t *testing.T) {
+	ctx := context.Background()
+	chainID := big.NewInt(1)
+	key, err := gethcrypto.GenerateKey()
+	require.NoError(t, err)
+	addr := gethcrypto.PubkeyToAddress(key.PublicKey)
+	to := common.HexToAddress("0x01")
+	tx := gethtypes.NewTx(&gethtypes.DynamicFeeTx{ChainID: chainID, Nonce: 1, GasTipCap: big.NewInt(1), GasFeeCap: big.NewInt(10), Gas: 21000, To: &to})
+
+	// the service signs the tx with another key
+	otherKey, err := gethcrypto.GenerateKey()
+	require.NoError(t, err)
+	remote := newStubRemoteSigner(t, &stubSignerService{key: otherKey}, addr)
+	_, err = remote.GetPublicKey(ctx)
+	require.Error(t, err)
+	s := &EthereumSigner{bytesSigner: remote, gethSigner: gethtypes.LatestSignerForChainID(chainID), addressCache: addr}
+	_, err = s.Sign(addr, tx)
+	require.ErrorContains(t, err, "unexpected sender")
+
+	// the service modifies the tx before signing it
+	service := &stubSignerService{key: key, tamper: func(tx *gethtypes.DynamicFeeTx) {
+		tx.GasFeeCap = big.NewInt(1000)
+	}}
+	s, err = NewEthereumSigner(ctx, newStubRemoteSigner(t, service, addr), chainID)
+	require.NoError(t, err)
+	_, err = s.Sign(addr, tx)
+	require.ErrorContains(t, err, "differs from the unsigned tx")
+
+	// the remote signer never signs digests
+	_, err = s.bytesSigner.Sign(ctx, make([]byte, 32))
+	require.ErrorIs(t, err, ErrNotSupported)
+}
@@ -0,0 +1,455 @@
+package ethereum
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/google/uuid"
+	"github.com/hyperledger-labs/yui-relayer/log"
+	"github.com/hyperledger-labs/yui-relayer/signer"
+)
+
+const signerRotationFileName = "signer_rotation.json"
+
+// signerRotation is a request to replace the relayer accounts, which is written to the data directory
+// by `ethereum rotate-signer` and applied between txs by the relayer processes of all the paths using the chain.
+// The request is kept after it is applied so that the processes started later also use the new accounts.
+type signerRotation struct {
+	// ID identifies the request so that each process applies it once
+	ID string `json:"id"`
+	// Signer is the new signer config in the same format as `signer` in the chain config
+	Signer json.RawMessage `json:"signer"`
+	// Sweep makes the remaining funds of the old accounts transferred to the new primary account
+	Sweep bool `json:"sweep"`
+	// Sweeping is set with SweepTxs by the first process that applies the request once the old accounts have no in-flight txs,
+	// after which every process waits for the sweep txs instead of sweeping again
+	Sweeping bool `json:"sweeping"`
+	// SweepTxs are the hashes of the sweep txs sent from the old accounts
+	SweepTxs []common.Hash `json:"sweep_txs,omitempty"`
+	// Applied is set once the old accounts are swept, or without sweeping if it's not requested,
+	// after which the processes switch to the new accounts without waiting
+	Applied bool `json:"applied"`
+}
+
+func (c *Chain) signerRotationPath() (string, error) {
+	dir, err := c.ensureDataDirectory()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, signerRotationFileName), nil
+}
+
+// decodeSignerConfig decodes the signer config in JSON with the codec given at Init
+func (c *Chain) decodeSignerConfig(bz []byte) (signer.SignerConfig, error) {
+	var config signer.SignerConfig
+	if err := c.codec.UnmarshalInterfaceJSON(bz, &config); err != nil {
+		return nil, fmt.Errorf("failed to decode signer config: %v", err)
+	}
+	if err := config.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid signer config: %v", err)
+	}
+	return config, nil
+}
+
+// RequestSignerRotation validates the new signer config and writes the rotation request
+// that is applied by the running relayer before its next tx.
+// In generate-only mode, the sweep txs of the current accounts (or only the account given by `--from`)
+// are written as unsigned txs instead of being sent by the relayer.
+func (c *Chain) RequestSignerRotation(ctx context.Context, signerConfig []byte, sweep bool) error {
+	config, err := c.decodeSignerConfig(signerConfig)
+	if err != nil {
+		return err
+	}
+	if g := generateOnlyFrom(ctx); g != nil {
+		if !sweep {
+			return errors.New("generate-only mode requires sweep because the sweep txs are the only txs of the rotation")
+		}
+		if err := c.generateSweepTxs(ctx, g, config); err != nil {
+			return err
+		}
+		// the funds are swept by the generated txs
+		sweep = false
+	} else if sweep {
+		if err := c.checkSweepPolicy(ctx, config); err != nil {
+			return err
+		}
+	}
+
+	path, err := c.signerRotationPath()
+	if err != nil {
+		return err
+	}
+	var rotation signerRotation
+	return updateDataFile(path, &rotation, func() bool {
+		rotation = signerRotation{ID: uuid.NewString(), Signer: signerConfig, Sweep: sweep}
+		return true
+	})
+}
+
+// checkSweepPolicy returns an error if tx_policy refuses the txs that sweep the current accounts to the new primary account,
+// so that the relayer doesn't retry them on every tx. Such accounts can be swept with `--generate-only` and `tx sign --signer-config`.
+func (c *Chain) checkSweepPolicy(ctx context.Context, config signer.SignerConfig) error {
+	policy := c.ethereumSigner.policy
+	if policy == nil {
+		return nil
+	}
+	newSigners, err := buildEthereumSigners(ctx, config, c.chainID)
+	if err != nil {
+		return err
+	}
+	to := newSigners[0].Address()
+	for _, s := range c.signers {
+		balance, err := c.client.BalanceAt(ctx, s.Address(), nil)
+		if err != nil {
+			return fmt.Errorf("failed to get balance: %w", err)
+		}
+		tx := gethtypes.NewTx(&gethtypes.LegacyTx{To: &to, Value: balance, Gas: params.TxGas, GasPrice: common.Big0})
+		if err := policy.Check(tx); err != nil {
+			return fmt.Errorf("sweep of %v is refused by tx_policy, use --generate-only and sign the txs with --signer-config instead: %w", s.Address(), err)
+		}
+	}
+	return nil
+}
+
+// generateSweepTxs writes the unsigned txs that sweep the funds of the current accounts to the new primary account
+func (c *Chain) generateSweepTxs(ctx context.Context, g *generateOnly, config signer.SignerConfig) error {
+	newSigners, err := buildEthereumSigners(ctx, config, c.chainID)
+	if err != nil {
+		return err
+	}
+	to := newSigners[0].Address()
+
+	var froms []common.Address
+	if g.from != (common.Address{}) {
+		froms = append(froms, g.from)
+	} else {
+		for _, s := range c.signers {
+			froms = append(froms, s.Address())
+		}
+	}
+	for _, from := range froms {
+		if from == to {
+			continue
+		}
+		// each account has its own nonces
+		if _, err := c.sweep(contextWithGenerateOnly(ctx, &generateOnly{out: g.out, from: from}), from, to); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// readSignerRotation returns the rotation request in the data directory, or nil if no rotation is requested
+func (c *Chain) readSignerRotation() (*signerRotation, error) {
+	path, err := c.signerRotationPath()
+	if err != nil {
+		return nil, err
+	}
+	bz, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	var rotation signerRotation
+	if err := json.Unmarshal(bz, &rotation); err != nil {
+		return nil, fmt.Errorf("failed to decode signer rotation: %v", err)
+	}
+	return &rotation, nil
+}
+
+// rotatedSigners returns the new signers of the rotation, which inherit the policy and the audit log from the old signers
+func (c *Chain) rotatedSigners(ctx context.Context, rotation *signerRotation) ([]*EthereumSigner, error) {
+	config, err := c.decodeSignerConfig(rotation.Signer)
+	if err != nil {
+		return nil, err
+	}
+	newSigners, err := buildEthereumSigners(ctx, config, c.chainID)
+	if err != nil {
+		return nil, err
+	}
+	for _, s := range newSigners {
+		s.policy = c.ethereumSigner.policy
+		s.auditLog = c.ethereumSigner.auditLog
+	}
+	return newSigners, nil
+}
+
+// applySignerRotation switches the relayer accounts to `newSigners`.
+//
+// CONTRACT: signerMu must be write-locked unless the chain is being initialized.
+func (c *Chain) applySignerRotation(ctx context.Context, rotation *signerRotation, newSigners []*EthereumSigner) {
+	oldAddr := c.ethereumSigner.Address()
+	c.ethereumSigner = *newSigners[0]
+	c.signers = append([]*EthereumSigner{&c.ethereumSigner}, newSigners[1:]...)
+	c.signerCursor.Store(0)
+	c.appliedSignerRotation = rotation.ID
+	c.GetChainLogger().InfoContext(ctx, "rotated signer", logAttrOldSigner, oldAddr.Hex(), logAttrSigner, c.ethereumSigner.Address().Hex())
+}
+
+// restoreSignerRotation switches to the accounts of the rotation applied before restarts or by the other processes
+func (c *Chain) restoreSignerRotation(ctx context.Context) error {
+	rotation, err := c.readSignerRotation()
+	if err != nil {
+		return err
+	} else if rotation == nil || !rotation.Applied {
+		return nil
+	}
+	newSigners, err := c.rotatedSigners(ctx, rotation)
+	if err != nil {
+		return err
+	}
+	c.applySignerRotation(ctx, rotation, newSigners)
+	return nil
+}
+
+// rotateSignerIfRequested replaces the relayer accounts if a rotation that this process hasn't applied is requested.
+// The first process that applies the rotation defers it to a later call while any old account has in-flight txs,
+// so that txs sent with the old nonces are not left behind, and sweeps the old accounts if requested.
+// The sweep txs are awaited without the locks so that the relayer processes are not blocked meanwhile.
+func (c *Chain) rotateSignerIfRequested(ctx context.Context) error {
+	if rotation, err := c.readSignerRotation(); err != nil {
+		return err
+	} else if rotation == nil || rotation.ID == c.appliedSignerRotation {
+		return nil
+	}
+
+	rotation, newSigners, swept, err := c.startSignerRotation(ctx)
+	if err != nil || rotation == nil {
+		return err
+	}
+	if rotation.Sweeping {
+		// the process that sent the sweep txs records their fees, and the others wait for the same txs
+		if err := c.waitForSweepTxs(ctx, rotation, swept); err != nil {
+			return err
+		}
+	}
+	return c.finishSignerRotation(ctx, rotation.ID, newSigners)
+}
+
+// startSignerRotation claims the rotation request under the file lock so that only one process waits for the old accounts
+// and sweeps them. It returns nil if the rotation is deferred, and `swept` is true if this process has sent the sweep txs.
+func (c *Chain) startSignerRotation(ctx context.Context) (rotation *signerRotation, newSigners []*EthereumSigner, swept bool, err error) {
+	logger := c.GetChainLogger()
+
+	c.signerMu.Lock()
+	defer c.signerMu.Unlock()
+
+	path, err := c.signerRotationPath()
+	if err != nil {
+		return nil, nil, false, err
+	}
+	var (
+		r         signerRotation
+		deferred  bool
+		updateErr error
+	)
+	err = updateDataFile(path, &r, func() bool {
+		if newSigners, updateErr = c.rotatedSigners(ctx, &r); updateErr != nil || r.Applied || r.Sweeping {
+			return false
+		}
+		for _, s := range c.signers {
+			var pending bool
+			if pending, updateErr = c.hasInFlightTxs(ctx, s.Address()); updateErr != nil {
+				return false
+			} else if pending {
+				logger.InfoContext(ctx, "defer signer rotation until in-flight txs of the old signer are included", logAttrSigner, s.Address().Hex())
+				deferred = true
+				return false
+			}
+		}
+		if r.Sweep {
+			for _, s := range c.signers {
+				if s.Address() == newSigners[0].Address() {
+					continue
+				}
+				tx, err := c.sweep(contextWithSigner(ctx, s), s.Address(), newSigners[0].Address())
+				if errors.Is(err, ErrTxPolicyViolation) {
+					// the sweep is not retried because the policy refuses it every time
+					logger.ErrorContext(ctx, "skip sweep refused by tx policy", err, logAttrSigner, s.Address().Hex())
+				} else if err != nil {
+					updateErr = err
+					return false
+				} else if tx != nil {
+					r.SweepTxs = append(r.SweepTxs, tx.Hash())
+				}
+			}
+		}
+		if len(r.SweepTxs) > 0 {
+			r.Sweeping = true
+			swept = true
+		} else {
+			r.Applied = true
+		}
+		return true
+	})
+	if err != nil {
+		return nil, nil, false, err
+	} else if updateErr != nil {
+		return nil, nil, false, updateErr
+	} else if deferred {
+		return nil, nil, false, nil
+	}
+	return &r, newSigners, swept, nil
+}
+
+// waitForSweepTxs waits until the sweep txs of the rotation are included.
+// If any of them fails, the sweep is reset so that it is retried by the next call.
+func (c *Chain) waitForSweepTxs(ctx context.Context, rotation *signerRotation, record bool) error {
+	logger := c.GetChainLogger()
+	for _, txHash := range rotation.SweepTxs {
+		receipt, err := c.client.WaitForReceiptAndGet(ctx, txHash)
+		if err != nil {
+			logger.ErrorContext(ctx, "failed to wait for sweep tx receipt", err, logAttrTxHash, txHash)
+			return err
+		}
+		if record {
+			c.recordTxFee(ctx, &receipt.Receipt)
+		}
+		if receipt.Status == gethtypes.ReceiptStatusFailed {
+			err := fmt.Errorf("sweep tx failed: tx_hash=%v", txHash)
+			logger.ErrorContext(ctx, "sweep tx failed", err, logAttrTxHash, txHash)
+			return c.resetSignerRotationSweep(rotation.ID, err)
+		}
+	}
+	return nil
+}
+
+// resetSignerRotationSweep clears the failed sweep of the rotation and returns `sweepErr`
+func (c *Chain) resetSignerRotationSweep(id string, sweepErr error) error {
+	path, err := c.signerRotationPath()
+	if err != nil {
+		return err
+	}
+	var rotation signerRotation
+	if err := updateDataFile(path, &rotation, func() bool {
+		if rotation.ID != id || !rotation.Sweeping {
+			return false
+		}
+		rotation.Sweeping = false
+		rotation.SweepTxs = nil
+		return true
+	}); err != nil {
+		return err
+	}
+	return sweepErr
+}
+
+// finishSignerRotation marks the rotation as applied and switches to the new accounts
+func (c *Chain) finishSignerRotation(ctx context.Context, id string, newSigners []*EthereumSigner) error {
+	c.signerMu.Lock()
+	defer c.signerMu.Unlock()
+
+	path, err := c.signerRotationPath()
+	if err != nil {
+		return err
+	}
+	var rotation signerRotation
+	if err := updateDataFile(path, &rotation, func() bool {
+		if rotation.ID != id || rotation.Applied {
+			return false
+		}
+		rotation.Sweeping = false
+		rotation.Applied = true
+		return true
+	}); err != nil {
+		return err
+	} else if rotation.ID != id {
+		// the request has been replaced by a newer one, which is applied by the next call
+		return nil
+	}
+	if c.appliedSignerRotation != id {
+		c.applySignerRotation(ctx, &rotation, newSigners)
+	}
+	return nil
+}
+
+// hasInFlightTxs returns true if the account has txs that are sent but not included in the latest block
+func (c *Chain) hasInFlightTxs(ctx context.Context, addr common.Address) (bool, error) {
+	pending, err := c.client.PendingNonceAt(ctx, addr)
+	if err != nil {
+		return false, fmt.Errorf("failed to get pending nonce: %w", err)
+	}
+	latest, err := c.client.NonceAt(ctx, addr, nil)
+	if err != nil {
+		return false, fmt.Errorf("failed to get nonce: %w", err)
+	}
+	return pending > latest, nil
+}
+
+// sweep transfers the balance of the account `from` minus the fees to `to`, and returns the sent tx without waiting for it.
+// It returns nil if the balance is less than the fees or the tx is written in generate-only mode.
+// `ctx` must be bound to the signer of `from`, or to the generate-only state whose sender is `from`.
+func (c *Chain) sweep(ctx context.Context, from common.Address, to common.Address) (*gethtypes.Transaction, error) {
+	logger := c.GetChainLogger()
+	logger = &log.RelayLogger{Logger: logger.With(logAttrSigner, from.Hex(), logAttrReceiver, to.Hex())}
+
+	txOpts, err := c.TxOpts(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+	balance, err := c.client.BalanceAt(ctx, from, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get balance: %w", err)
+	}
+
+	// the L1 data fee is included in the gas on Arbitrum, so the gas of the transfer is estimated there
+	txOpts.GasLimit = params.TxGas
+	if c.l2FeeModel != nil && c.l2FeeModel.ChargedInL2Gas() {
+		if txOpts.GasLimit, err = c.client.EstimateGas(ctx, ethereum.CallMsg{From: from, To: &to}); err != nil {
+			return nil, fmt.Errorf("failed to estimate gas: %w", err)
+		}
+	}
+	gasPrice := txOpts.GasPrice
+	if gasPrice == nil {
+		gasPrice = txOpts.GasFeeCap
+	}
+	fee := new(big.Int).Mul(gasPrice, new(big.Int).SetUint64(txOpts.GasLimit))
+
+	// the L1 data fee charged separately on OP-stack chains is estimated with the balance as the value,
+	// whose encoding is not shorter than that of the actual amount
+	if c.l2FeeModel != nil && !c.l2FeeModel.ChargedInL2Gas() {
+		tx := gethtypes.NewTx(&gethtypes.DynamicFeeTx{
+			ChainID:   c.chainID,
+			Nonce:     txOpts.Nonce.Uint64(),
+			GasTipCap: gasPrice,
+			GasFeeCap: gasPrice,
+			Gas:       txOpts.GasLimit,
+			To:        &to,
+			Value:     balance,
+		})
+		l1Fee, err := c.l2FeeModel.EstimateL1Fee(ctx, tx, from)
+		if err != nil {
+			return nil, fmt.Errorf("failed to estimate L1 fee: %w", err)
+		}
+		logger = &log.RelayLogger{Logger: logger.With(logAttrL1Fee, l1Fee.String())}
+		fee.Add(fee, l1Fee)
+	}
+
+	amount := new(big.Int).Sub(balance, fee)
+	if amount.Sign() <= 0 {
+		logger.InfoContext(ctx, "skip sweep because the balance is less than the fee", logAttrBalance, balance.String())
+		return nil, nil
+	}
+	txOpts.Value = amount
+	logger = &log.RelayLogger{Logger: logger.With(logAttrAmount, amount.String())}
+
+	logger.InfoContext(ctx, "sweep the old relayer account")
+	tx, err := bind.NewBoundContract(to, abi.ABI{}, nil, c.client, nil).Transfer(txOpts)
+	if err != nil || generateOnlyFrom(ctx) != nil {
+		return nil, processSendTxResult(ctx, logger, c, tx, err)
+	}
+	logger.InfoContext(ctx, "sent sweep tx", logAttrTxHash, tx.Hash())
+	return tx, nil
+}
@@ -0,0 +1,272 @@
+package ethereum
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
+	gethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/google/uuid"
+	"github.com/hyperledger-labs/yui-relayer/log"
+	"github.com/stretchr/testify/require"
+)
+
+// rotationTestEthService reports in-flight txs by the pending nonce and accepts sweep txs,
+// which are included with `status` once `included` is closed, or immediately if it is nil
+type rotationTestEthService struct {
+	broadcastTestEthService
+	pendingNonce uint64
+	status       uint64
+	included     chan struct{}
+}
+
+func (s *rotationTestEthService) GetTransactionReceipt(txHash common.Hash) (*gethtypes.Receipt, error) {
+	if s.included != nil {
+		<-s.included
+	}
+	return &gethtypes.Receipt{Status: s.status, TxHash: txHash, GasUsed: 21000, Logs: []*gethtypes.Log{}}, nil
+}
+
+func (s *rotationTestEthService) GetTransactionCount(address common.Address, block string) (hexutil.Uint64, error) {
+	if block == "pending" {
+		return hexutil.Uint64(s.pendingNonce), nil
+	}
+	return 1, nil
+}
+
+func (s *rotationTestEthService) GetBalance(address common.Address, block string) (*hexutil.Big, error) {
+	return (*hexutil.Big)(big.NewInt(1_000_000_000_000_000_000)), nil
+}
+
+// newKeystoreSignerConfigJSON returns a keystore signer config in JSON and the address of its key
+func newKeystoreSignerConfigJSON(t *testing.T) ([]byte, common.Address) {
+	privateKey, err := gethcrypto.GenerateKey()
+	require.NoError(t, err)
+	key := &keystore.Key{
+		Id:         uuid.New(),
+		Address:    gethcrypto.PubkeyToAddress(privateKey.PublicKey),
+		PrivateKey: privateKey,
+	}
+	keyJSON, err := keystore.EncryptKey(key, "password", keystore.LightScryptN, keystore.LightScryptP)
+	require.NoError(t, err)
+
+	dir := t.TempDir()
+	keyPath := filepath.Join(dir, "key.json")
+	require.NoError(t, os.WriteFile(keyPath, keyJSON, 0600))
+	t.Setenv("TEST_KEYSTORE_PASSWORD", "password")
+
+	config := fmt.Sprintf(`{"@type":"/relayer.chains.ethereum.config.KeystoreSignerConfig","path":%q,"password_env":"TEST_KEYSTORE_PASSWORD"}`, keyPath)
+	return []byte(config), key.Address
+}
+
+func newRotationTestChain(t *testing.T, service *rotationTestEthService) *Chain {
+	c := newAuditTestChain(t)
+
+	registry := codectypes.NewInterfaceRegistry()
+	RegisterInterfaces(registry)
+	c.codec = codec.NewProtoCodec(registry)
+
+	withTestEthService(t, c, service)
+	return c
+}
+
+func TestSignerRotation(t *testing.T) {
+	if err := log.InitLogger("DEBUG", "text", "null", false); err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	service := &rotationTestEthService{pendingNonce: 2, status: gethtypes.ReceiptStatusSuccessful}
+	c := newRotationTestChain(t, service)
+	oldAddr := c.ethereumSigner.Address()
+
+	signerConfig, newAddr := newKeystoreSignerConfigJSON(t)
+	require.Error(t, c.RequestSignerRotation(ctx, []byte(`{"@type":"/relayer.chains.ethereum.config.KeystoreSignerConfig"}`), false))
+	require.NoError(t, c.RequestSignerRotation(ctx, signerConfig, true))
+
+	// the rotation is deferred while the old account has an in-flight tx
+	require.NoError(t, c.rotateSignerIfRequested(ctx))
+	require.Equal(t, oldAddr, c.ethereumSigner.Address())
+	require.Empty(t, service.sent)
+
+	// the funds are swept to the new account before the rotation
+	service.pendingNonce = 1
+	require.NoError(t, c.rotateSignerIfRequested(ctx))
+	require.Equal(t, newAddr, c.ethereumSigner.Address())
+	require.Len(t, c.signers, 1)
+	require.Equal(t, &c.ethereumSigner, c.signers[0])
+	require.NotNil(t, c.ethereumSigner.auditLog)
+	require.Len(t, service.sent, 1)
+	require.Equal(t, newAddr, *service.sent[0].To())
+	require.Equal(t, uint64(21000), service.sent[0].Gas())
+	fee := new(big.Int).Mul(service.sent[0].GasPrice(), big.NewInt(21000))
+	require.Equal(t, big.NewInt(1_000_000_000_000_000_000), new(big.Int).Add(service.sent[0].Value(), fee))
+
+	// the request is applied once by this process
+	require.NoError(t, c.rotateSignerIfRequested(ctx))
+	require.Equal(t, newAddr, c.ethereumSigner.Address())
+	require.Len(t, service.sent, 1)
+
+	// the request is kept so that the relayer restarted later uses the new account
+	restarted := newRotationTestChain(t, service)
+	restarted.homePath = c.homePath
+	require.NoError(t, restarted.restoreSignerRotation(ctx))
+	require.Equal(t, newAddr, restarted.ethereumSigner.Address())
+
+	// another process using the chain switches the accounts without sweeping them again
+	other := newRotationTestChain(t, service)
+	other.homePath = c.homePath
+	require.NoError(t, other.rotateSignerIfRequested(ctx))
+	require.Equal(t, newAddr, other.ethereumSigner.Address())
+	require.Len(t, service.sent, 1)
+}
+
+func TestSignerRotationSweepWithoutLocks(t *testing.T) {
+	if err := log.InitLogger("DEBUG", "text", "null", false); err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	service := &rotationTestEthService{pendingNonce: 1, status: gethtypes.ReceiptStatusFailed}
+	c := newRotationTestChain(t, service)
+	oldAddr := c.ethereumSigner.Address()
+	signerConfig, newAddr := newKeystoreSignerConfigJSON(t)
+	require.NoError(t, c.RequestSignerRotation(ctx, signerConfig, true))
+
+	// the failed sweep is reset so that it is retried by the next call
+	require.Error(t, c.rotateSignerIfRequested(ctx))
+	require.Equal(t, oldAddr, c.ethereumSigner.Address())
+	rotation, err := c.readSignerRotation()
+	require.NoError(t, err)
+	require.False(t, rotation.Sweeping)
+	require.Empty(t, rotation.SweepTxs)
+
+	// neither the signers nor the request are locked while the sweep tx is awaited
+	service.status = gethtypes.ReceiptStatusSuccessful
+	service.included = make(chan struct{})
+	other := newRotationTestChain(t, service)
+	other.homePath = c.homePath
+	var wg sync.WaitGroup
+	for _, chain := range []*Chain{c, other} {
+		wg.Add(1)
+		go func(chain *Chain) {
+			defer wg.Done()
+			require.NoError(t, chain.rotateSignerIfRequested(ctx))
+		}(chain)
+	}
+	require.Eventually(t, func() bool {
+		rotation, err := c.readSignerRotation()
+		return err == nil && rotation.Sweeping
+	}, 5*time.Second, 10*time.Millisecond)
+	require.Eventually(t, func() bool {
+		if !c.signerMu.TryLock() {
+			return false
+		}
+		c.signerMu.Unlock()
+		return true
+	}, 5*time.Second, 10*time.Millisecond)
+	path, err := c.signerRotationPath()
+	require.NoError(t, err)
+	require.NoError(t, updateDataFile(path, &signerRotation{}, func() bool { return false }))
+
+	// both processes switch to the new account once the sweep tx is included, which is sent only once
+	close(service.included)
+	wg.Wait()
+	require.Equal(t, newAddr, c.ethereumSigner.Address())
+	require.Equal(t, newAddr, other.ethereumSigner.Address())
+	require.Len(t, service.sent, 2)
+	rotation, err = c.readSignerRotation()
+	require.NoError(t, err)
+	require.True(t, rotation.Applied)
+}
+
+func TestSignerRotationSweepFee(t *testing.T) {
+	if err := log.InitLogger("DEBUG", "text", "null", false); err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	service := &rotationTestEthService{pendingNonce: 1, status: gethtypes.ReceiptStatusSuccessful}
+	c := newRotationTestChain(t, service)
+	c.config.L2FeeModel = L2FeeModelOPStack
+	c.l2FeeModel = NewL2FeeModel(&l2FeeCaller{t: t}, &c.config)
+
+	// the L1 fee charged on OP-stack chains is left in the old account
+	signerConfig, newAddr := newKeystoreSignerConfigJSON(t)
+	require.NoError(t, c.RequestSignerRotation(ctx, signerConfig, true))
+	require.NoError(t, c.rotateSignerIfRequested(ctx))
+	require.Equal(t, newAddr, c.ethereumSigner.Address())
+	require.Len(t, service.sent, 1)
+	fee := new(big.Int).Mul(service.sent[0].GasPrice(), big.NewInt(21000))
+	require.Equal(t, -1, new(big.Int).Add(service.sent[0].Value(), fee).Cmp(big.NewInt(1_000_000_000_000_000_000)))
+}
+
+func TestSignerRotationPolicy(t *testing.T) {
+	if err := log.InitLogger("DEBUG", "text", "null", false); err != nil {
+		t.Fatal(err)
+	}
+	service := &rotationTestEthService{pendingNonce: 1, status: gethtypes.ReceiptStatusSuccessful}
+	c := newRotationTestChain(t, service)
+	c.config.TxPolicy = &TxPolicyConfig{}
+	policy, err := NewTxPolicy(c.config, nil)
+	require.NoError(t, err)
+	c.ethereumSigner.policy = policy
+	signerConfig, _ := newKeystoreSignerConfigJSON(t)
+
+	// the sweep refused by tx_policy is rejected when it is requested
+	err = c.RequestSignerRotation(context.Background(), signerConfig, true)
+	require.ErrorIs(t, err, ErrTxPolicyViolation)
+	rotation, err := c.readSignerRotation()
+	require.NoError(t, err)
+	require.Nil(t, rotation)
+
+	// the rotation without the sweep is accepted
+	require.NoError(t, c.RequestSignerRotation(context.Background(), signerConfig, false))
+}
+
+func TestSignerRotationGenerateOnly(t *testing.T) {
+	if err := log.InitLogger("DEBUG", "text", "null", false); err != nil {
+		t.Fatal(err)
+	}
+	service := &rotationTestEthService{pendingNonce: 1, status: gethtypes.ReceiptStatusSuccessful}
+	c := newRotationTestChain(t, service)
+	signerConfig, newAddr := newKeystoreSignerConfigJSON(t)
+	oldSignerConfig, oldAddr := newKeystoreSignerConfigJSON(t)
+
+	// the sweep tx of the account given by --from is generated instead of being sent by the relayer
+	var out bytes.Buffer
+	ctx := contextWithGenerateOnly(context.Background(), &generateOnly{out: &out, from: oldAddr})
+	require.Error(t, c.RequestSignerRotation(ctx, signerConfig, false))
+	require.NoError(t, c.RequestSignerRotation(ctx, signerConfig, true))
+	var args txArgs
+	require.NoError(t, json.Unmarshal(out.Bytes(), &args))
+	require.Equal(t, oldAddr, args.From)
+	require.Equal(t, newAddr, *args.To)
+	require.Equal(t, uint64(21000), uint64(args.Gas))
+	fee := new(big.Int).Mul(args.GasPrice.ToInt(), big.NewInt(21000))
+	require.Equal(t, big.NewInt(1_000_000_000_000_000_000), new(big.Int).Add(args.Value.ToInt(), fee))
+
+	// the relayer switches the accounts without sweeping them again
+	require.NoError(t, c.rotateSignerIfRequested(context.Background()))
+	require.Equal(t, newAddr, c.ethereumSigner.Address())
+	require.Empty(t, service.sent)
+
+	// the account that is not configured for the chain signs the tx with its signer config
+	_, err := c.SignUnsignedTx(context.Background(), &args)
+	require.Error(t, err)
+	signed, err := c.SignUnsignedTxWithSignerConfig(context.Background(), &args, oldSignerConfig)
+	require.NoError(t, err)
+	sender, err := gethtypes.Sender(c.ethereumSigner.gethSigner, signed)
+	require.NoError(t, err)
+	require.Equal(t, oldAddr, sender)
+}
@@ -0,0 +1,140 @@
+package ethereum
+
+import (
+	"bytes"
+	"math/big"
+	"path/filepath"
+	"sort"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/datachainlab/ethereum-ibc-relay-chain/pkg/client/txpool"
+)
+
+const sentTxsFileName = "sent_txs.json"
+
+type sentTxKey struct {
+	sender common.Address
+	nonce  uint64
+}
+
+// sentTxTracker remembers the fees of the latest txs sent by the relayer accounts for each (sender, nonce),
+// so that the txs can be replaced with bumped fees on nodes without `txpool_contentFrom`.
+// If `path` is set, the txs are journaled in the file shared by the relayer processes using the data directory,
+// so that they are known after restarts and by the other processes sending txs from the same accounts.
+// All methods are no-op if the receiver is nil.
+type sentTxTracker struct {
+	mu   sync.Mutex
+	path string
+	txs  map[sentTxKey]*txpool.RPCTransaction
+}
+
+// newSentTxTracker returns a tracker that journals the txs in the file at `path`, or keeps them in memory if `path` is empty
+func newSentTxTracker(path string) *sentTxTracker {
+	return &sentTxTracker{path: path, txs: make(map[sentTxKey]*txpool.RPCTransaction)}
+}
+
+// update calls `fn` with the txs loaded from the journal, and writes them back if `fn` returns true.
+// If the journal is unavailable, `fn` is applied to the txs in memory and the error is returned.
+func (t *sentTxTracker) update(fn func(txs map[sentTxKey]*txpool.RPCTransaction) bool) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.path == "" {
+		fn(t.txs)
+		return nil
+	}
+	var journal []*txpool.RPCTransaction
+	err := updateDataFile(t.path, &journal, func() bool {
+		t.txs = make(map[sentTxKey]*txpool.RPCTransaction, len(journal))
+		for _, tx := range journal {
+			t.txs[sentTxKey{tx.From, uint64(tx.Nonce)}] = tx
+		}
+		if !fn(t.txs) {
+			return false
+		}
+		journal = journal[:0]
+		for _, tx := range t.txs {
+			journal = append(journal, tx)
+		}
+		sort.Slice(journal, func(i, j int) bool {
+			if c := bytes.Compare(journal[i].From[:], journal[j].From[:]); c != 0 {
+				return c < 0
+			}
+			return journal[i].Nonce < journal[j].Nonce
+		})
+		return true
+	})
+	if err != nil {
+		fn(t.txs)
+	}
+	return err
+}
+
+// Record remembers the fees of the tx sent from `sender`, which replaces the one with the same sender and nonce.
+// Txs of the sender with lower nonces are pruned because they must have been included.
+// The gas fee cap and the gas tip cap of legacy txs are their gas price as txpool_contentFrom doesn't return them.
+func (t *sentTxTracker) Record(sender common.Address, tx *gethtypes.Transaction) error {
+	if t == nil {
+		return nil
+	}
+	return t.update(func(txs map[sentTxKey]*txpool.RPCTransaction) bool {
+		for key := range txs {
+			if key.sender == sender && key.nonce < tx.Nonce() {
+				delete(txs, key)
+			}
+		}
+		txs[sentTxKey{sender, tx.Nonce()}] = &txpool.RPCTransaction{
+			From:      sender,
+			Hash:      tx.Hash(),
+			Nonce:     hexutil.Uint64(tx.Nonce()),
+			GasPrice:  (*hexutil.Big)(tx.GasPrice()),
+			GasFeeCap: (*hexutil.Big)(tx.GasFeeCap()),
+			GasTipCap: (*hexutil.Big)(tx.GasTipCap()),
+		}
+		return true
+	})
+}
+
+// MinimumRequiredFee returns the last tx sent with the nonce and the minimum fees required to replace it,
+// or zero fees if no tx is known. Txs with lower nonces are pruned because they must have been included.
+func (t *sentTxTracker) MinimumRequiredFee(sender common.Address, nonce uint64, priceBump uint64) (*txpool.RPCTransaction, *big.Int, *big.Int, error) {
+	if t == nil {
+		return nil, common.Big0, common.Big0, nil
+	}
+	var oldTx *txpool.RPCTransaction
+	err := t.update(func(txs map[sentTxKey]*txpool.RPCTransaction) bool {
+		var pruned bool
+		for key := range txs {
+			if key.sender == sender && key.nonce < nonce {
+				delete(txs, key)
+				pruned = true
+			}
+		}
+		oldTx = txs[sentTxKey{sender, nonce}]
+		return pruned
+	})
+	if oldTx == nil {
+		return nil, common.Big0, common.Big0, err
+	}
+	gasFeeCap, gasTipCap := txpool.MinimumRequiredFee(oldTx, priceBump)
+	return oldTx, gasFeeCap, gasTipCap, err
+}
+
+// openSentTxJournal makes the chain client journal the sent txs in the data directory.
+// Txs are not journaled if `price_bump` is zero because they are never replaced.
+func (c *Chain) openSentTxJournal() error {
+	if c.config.PriceBump == 0 {
+		c.client.sentTxs = nil
+		return nil
+	}
+	dir, err := c.ensureDataDirectory()
+	if err != nil {
+		return err
+	}
+	c.client.sentTxs = newSentTxTracker(filepath.Join(dir, sentTxsFileName))
+	return nil
+}
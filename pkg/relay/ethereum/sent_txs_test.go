@@ -0,0 +1,113 @@
+package ethereum
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/hyperledger-labs/yui-relayer/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/datachainlab/ethereum-ibc-relay-chain/pkg/client/txpool"
+)
+
+func TestPriceBumpWithoutTxpool(t *testing.T) {
+	if err := log.InitLogger("DEBUG", "text", "null", false); err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	c := newAuditTestChain(t)
+	c.config.PriceBump = 10
+
+	// the node doesn't expose txpool_contentFrom
+	service := &broadcastTestEthService{}
+	withTestEthService(t, c, service)
+	require.NoError(t, c.openSentTxJournal())
+
+	txOpts, err := c.TxOpts(ctx, true)
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(1_000_000_000), txOpts.GasPrice)
+
+	// the tx with the same nonce is replaced with the bumped fee
+	to := common.HexToAddress("0x01")
+	tx, err := c.ethereumSigner.Sign(c.ethereumSigner.Address(), gethtypes.NewTx(&gethtypes.LegacyTx{Nonce: 1, GasPrice: big.NewInt(1_000_000_000), Gas: 21000, To: &to}))
+	require.NoError(t, err)
+	require.NoError(t, c.client.SendTransaction(ctx, tx))
+	txOpts, err = c.TxOpts(ctx, true)
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(1_100_000_000), txOpts.GasPrice)
+
+	// txs signed but not sent are not journaled
+	_, err = c.ethereumSigner.Sign(c.ethereumSigner.Address(), gethtypes.NewTx(&gethtypes.LegacyTx{Nonce: 1, GasPrice: big.NewInt(2_000_000_000), Gas: 21000, To: &to}))
+	require.NoError(t, err)
+
+	// the txs sent before restarts or by the other processes are loaded from the journal
+	require.NoError(t, c.openSentTxJournal())
+	txOpts, err = c.TxOpts(ctx, true)
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(1_100_000_000), txOpts.GasPrice)
+
+	// txs with lower nonces are pruned from the journal once a higher nonce is used
+	oldTx, minFeeCap, _, err := c.client.sentTxs.MinimumRequiredFee(c.ethereumSigner.Address(), 2, 10)
+	require.NoError(t, err)
+	require.Nil(t, oldTx)
+	require.Zero(t, minFeeCap.Sign())
+	require.NoError(t, c.openSentTxJournal())
+	oldTx, _, _, err = c.client.sentTxs.MinimumRequiredFee(c.ethereumSigner.Address(), 1, 10)
+	require.NoError(t, err)
+	require.Nil(t, oldTx)
+
+	// admin txs sent through the bound contracts are journaled as well
+	require.NoError(t, c.CloseChannel(ctx, "transfer", "channel-0"))
+	adminTx := service.sent[len(service.sent)-1]
+	oldTx, _, _, err = c.client.sentTxs.MinimumRequiredFee(c.ethereumSigner.Address(), adminTx.Nonce(), 10)
+	require.NoError(t, err)
+	require.NotNil(t, oldTx)
+	require.Equal(t, adminTx.Hash(), oldTx.Hash)
+}
+
+// txpoolTestService serves txpool_contentFrom that finds no pending tx
+type txpoolTestService struct{}
+
+func (txpoolTestService) ContentFrom(address common.Address) (map[string]map[string]*txpool.RPCTransaction, error) {
+	return map[string]map[string]*txpool.RPCTransaction{"pending": {}, "queued": {}}, nil
+}
+
+func TestSentTxJournal(t *testing.T) {
+	if err := log.InitLogger("DEBUG", "text", "null", false); err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	c := newAuditTestChain(t)
+	service := &broadcastTestEthService{}
+	withTestRPCServices(t, c, map[string]interface{}{"eth": service, "txpool": txpoolTestService{}})
+
+	// txs are not journaled without price bumps
+	require.NoError(t, c.openSentTxJournal())
+	require.Nil(t, c.client.sentTxs)
+
+	c.config.PriceBump = 10
+	require.NoError(t, c.openSentTxJournal())
+	to := common.HexToAddress("0x01")
+	sign := func(nonce uint64) *gethtypes.Transaction {
+		tx, err := c.ethereumSigner.Sign(c.ethereumSigner.Address(), gethtypes.NewTx(&gethtypes.LegacyTx{Nonce: nonce, GasPrice: big.NewInt(1_000_000_000), Gas: 21000, To: &to}))
+		require.NoError(t, err)
+		return tx
+	}
+	require.NoError(t, c.client.SendTransaction(ctx, sign(1)))
+	require.NoError(t, c.client.SendTransaction(ctx, sign(2)))
+
+	// the journal is not used if txpool_contentFrom doesn't find the tx, which may have been dropped by the node
+	oldTx, minFeeCap, _, err := c.client.GetMinimumRequiredFee(ctx, c.ethereumSigner.Address(), 2, 10)
+	require.NoError(t, err)
+	require.Nil(t, oldTx)
+	require.Zero(t, minFeeCap.Sign())
+
+	// txs with lower nonces are pruned when a tx is recorded
+	oldTx, _, _, err = c.client.sentTxs.MinimumRequiredFee(c.ethereumSigner.Address(), 2, 10)
+	require.NoError(t, err)
+	require.NotNil(t, oldTx)
+	require.Len(t, c.client.sentTxs.txs, 1)
+}
@@ -5,19 +5,40 @@ import (
 	"fmt"
 	"math/big"
 
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 	gethtypes "github.com/ethereum/go-ethereum/core/types"
 	gethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/hyperledger-labs/yui-relayer/core"
 	"github.com/hyperledger-labs/yui-relayer/log"
 	"github.com/hyperledger-labs/yui-relayer/signer"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 )
 
+// EthereumSigner signs txs with a signer.Signer.
+// It has no mutable state, so it can be shared among goroutines.
 type EthereumSigner struct {
 	bytesSigner  signer.Signer
 	gethSigner   gethtypes.Signer
 	addressCache common.Address
-	logger       *log.RelayLogger
-	NoSign       bool
+	// txs are checked by the policy before signing if it is not nil
+	policy *TxPolicy
+	// signed txs are recorded in the audit log if it is not nil
+	auditLog *auditLog
+}
+
+// SignOptions are the options given per signing call
+type SignOptions struct {
+	// Logger logs the signing if it is not nil
+	Logger *log.RelayLogger
+	// NoSign makes the signer return the tx as is (e.g. for gas estimation)
+	NoSign bool
+	// MsgTypes are the types of the msgs included in the tx, which are recorded in the audit log
+	MsgTypes []string
+	// Path is the path for which the tx is sent, which is recorded in the audit log
+	Path *core.PathEnd
 }
 
 func NewEthereumSigner(ctx context.Context, bytesSigner signer.Signer, chainID *big.Int) (*EthereumSigner, error) {
@@ -39,46 +60,109 @@ func NewEthereumSigner(ctx context.Context, bytesSigner signer.Signer, chainID *
 		bytesSigner:  bytesSigner,
 		gethSigner:   gethSigner,
 		addressCache: addr,
-		logger:       nil,
-		NoSign:       false,
 	}, nil
 }
 
-func (s *EthereumSigner) GetLogger() *log.RelayLogger {
-	return s.logger
+func (s *EthereumSigner) Address() common.Address {
+	return s.addressCache
 }
 
-func (s *EthereumSigner) SetLogger(logger *log.RelayLogger) {
-	s.logger = logger
+// SignerFn returns a bind.SignerFn that signs txs with the options.
+// The function captures `ctx` so that cancellation and tracing reach the underlying signer.Signer,
+// which means that a new function should be created for each build of txs.
+func (s *EthereumSigner) SignerFn(ctx context.Context, opts SignOptions) bind.SignerFn {
+	return func(address common.Address, tx *gethtypes.Transaction) (*gethtypes.Transaction, error) {
+		return s.SignWithOptions(ctx, opts, address, tx)
+	}
 }
 
-func (s *EthereumSigner) Address() common.Address {
-	return s.addressCache
+// Sign signs the tx with the default options.
+// Since no context is given, use SignerFn or SignWithOptions to make the signing cancellable.
+func (s *EthereumSigner) Sign(address common.Address, tx *gethtypes.Transaction) (*gethtypes.Transaction, error) {
+	return s.SignWithOptions(context.Background(), SignOptions{}, address, tx)
 }
 
-func (s *EthereumSigner) Sign(address common.Address, tx *gethtypes.Transaction) (*gethtypes.Transaction, error) {
+func (s *EthereumSigner) SignWithOptions(ctx context.Context, opts SignOptions, address common.Address, tx *gethtypes.Transaction) (*gethtypes.Transaction, error) {
 	if address != s.Address() {
 		return nil, fmt.Errorf("unauthorized address: authorized=%v, given=%v", s.Address(), address)
 	}
 
-	if s.NoSign {
+	if opts.NoSign {
 		return tx, nil
 	}
 
 	txHash := s.gethSigner.Hash(tx)
 
-	if s.logger != nil {
-		s.logger.Info("try to sign", "address", address, "txHash", txHash.Hex())
+	if s.policy != nil {
+		if err := s.policy.Check(tx); err != nil {
+			logger := opts.Logger
+			if logger == nil {
+				logger = GetModuleLogger()
+			}
+			logger.ErrorContext(ctx, "tx refused by policy", err, "address", address, "txHash", txHash.Hex())
+			return nil, err
+		}
+	}
+
+	ctx, span := tracer.Start(ctx, "EthereumSigner.Sign", trace.WithAttributes(
+		attribute.String("address", address.Hex()),
+		attribute.String("tx_hash", txHash.Hex()),
+	))
+	defer span.End()
+
+	if opts.Logger != nil {
+		opts.Logger.InfoContext(ctx, "try to sign", "address", address, "txHash", txHash.Hex())
+	}
+
+	var signed *gethtypes.Transaction
+	if txSigner, ok := s.bytesSigner.(TxSigner); ok {
+		var err error
+		if signed, err = s.signTxWith(ctx, txSigner, address, tx, txHash); err != nil {
+			span.SetStatus(codes.Error, err.Error())
+			return nil, err
+		}
+	} else {
+		sig, err := s.bytesSigner.Sign(ctx, txHash.Bytes())
+		if err != nil {
+			span.SetStatus(codes.Error, err.Error())
+			return nil, fmt.Errorf("failed to sign tx: %w", err)
+		}
+		if signed, err = tx.WithSignature(s.gethSigner, sig); err != nil {
+			return nil, err
+		}
 	}
 
-	// NOTE: This method is called from methods in the go-ethereum package so we cannot pass a context to this method,
-	//   which means that we cannot cancel Sign method even if the process receives a signal to stop.
-	//   Although we can set a context in a similar way to SetLogger does, leave context.TODO() for now
-	//   because it seems rare to receive a signal while signing a transaction.
-	sig, err := s.bytesSigner.Sign(context.TODO(), txHash.Bytes())
+	// the signed tx is not returned unless it is recorded
+	if err := s.auditLog.Append(newAuditEntry(address, signed, opts)); err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		return nil, fmt.Errorf("failed to append audit log: %w", err)
+	}
+
+	return signed, nil
+}
+
+// signTxWith signs the tx with the TxSigner and checks that the signed tx is
+// the same as the unsigned one and is signed by `address`
+func (s *EthereumSigner) signTxWith(ctx context.Context, txSigner TxSigner, address common.Address, tx *gethtypes.Transaction, txHash common.Hash) (*gethtypes.Transaction, error) {
+	signed, err := txSigner.SignTx(ctx, address, tx, s.gethSigner.ChainID())
 	if err != nil {
-		return nil, fmt.Errorf("failed to sign tx: %v", err)
+		return nil, fmt.Errorf("failed to sign tx: %w", err)
+	}
+	if signedHash := s.gethSigner.Hash(signed); signedHash != txHash {
+		return nil, fmt.Errorf("signed tx differs from the unsigned tx: expected=%v, actual=%v", txHash, signedHash)
 	}
+	sender, err := gethtypes.Sender(s.gethSigner, signed)
+	if err != nil {
+		return nil, fmt.Errorf("failed to recover sender of signed tx: %w", err)
+	} else if sender != address {
+		return nil, fmt.Errorf("signed tx has unexpected sender: expected=%v, actual=%v", address, sender)
+	}
+	return signed, nil
+}
 
-	return tx.WithSignature(s.gethSigner, sig)
+// withSignOptions returns a copy of `opts` whose signer signs txs with `signOpts`
+func withSignOptions(opts *bind.TransactOpts, s *EthereumSigner, signOpts SignOptions) *bind.TransactOpts {
+	cloned := *opts
+	cloned.Signer = s.SignerFn(opts.Context, signOpts)
+	return &cloned
 }
@@ -0,0 +1,135 @@
+package ethereum
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	"github.com/hyperledger-labs/yui-relayer/signer"
+)
+
+var (
+	_ signer.SignerConfig                = (*SignerPoolConfig)(nil)
+	_ codectypes.UnpackInterfacesMessage = (*SignerPoolConfig)(nil)
+)
+
+func (c *SignerPoolConfig) Validate() error {
+	if len(c.Signers) == 0 {
+		return errors.New("config attribute \"signers\" is empty")
+	}
+	for i, s := range c.Signers {
+		if s == nil {
+			return fmt.Errorf("config attribute \"signers[%d]\" is empty", i)
+		}
+		sc, ok := s.GetCachedValue().(signer.SignerConfig)
+		if !ok {
+			return fmt.Errorf("config attribute \"signers[%d]\" is not a signer config", i)
+		} else if _, ok := sc.(*SignerPoolConfig); ok {
+			return fmt.Errorf("config attribute \"signers[%d]\" must not be a signer pool", i)
+		} else if err := sc.Validate(); err != nil {
+			return fmt.Errorf("config attribute \"signers[%d]\" is invalid: %v", i, err)
+		}
+	}
+	return nil
+}
+
+// Build returns the signer of the first account in the pool, which is used as the primary relayer account.
+// Use BuildAll to build the signers of all the accounts.
+func (c *SignerPoolConfig) Build() (signer.Signer, error) {
+	if len(c.Signers) == 0 {
+		return nil, errors.New("signer pool is empty")
+	}
+	return c.Signers[0].GetCachedValue().(signer.SignerConfig).Build()
+}
+
+// BuildAll returns the signers of all the accounts in the pool
+func (c *SignerPoolConfig) BuildAll() ([]signer.Signer, error) {
+	signers := make([]signer.Signer, len(c.Signers))
+	for i, s := range c.Signers {
+		var err error
+		if signers[i], err = s.GetCachedValue().(signer.SignerConfig).Build(); err != nil {
+			return nil, fmt.Errorf("failed to build signers[%d]: %v", i, err)
+		}
+	}
+	return signers, nil
+}
+
+func (c *SignerPoolConfig) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for i, s := range c.Signers {
+		if err := unpacker.UnpackAny(s, new(signer.SignerConfig)); err != nil {
+			return fmt.Errorf("failed to unpack SignerPoolConfig attribute \"signers[%d]\": %v", i, err)
+		}
+	}
+	return nil
+}
+
+// buildSigners returns the signers of the relayer accounts specified by the signer config,
+// which is either a single signer or a signer pool
+func buildSigners(config signer.SignerConfig) ([]signer.Signer, error) {
+	if pool, ok := config.(*SignerPoolConfig); ok {
+		return pool.BuildAll()
+	}
+	s, err := config.Build()
+	if err != nil {
+		return nil, err
+	}
+	return []signer.Signer{s}, nil
+}
+
+// buildEthereumSigners returns the ethereum signers of the relayer accounts specified by the signer config
+func buildEthereumSigners(ctx context.Context, config signer.SignerConfig, chainID *big.Int) ([]*EthereumSigner, error) {
+	bytesSigners, err := buildSigners(config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build signer: %v", err)
+	}
+	ethereumSigners := make([]*EthereumSigner, len(bytesSigners))
+	for i, bytesSigner := range bytesSigners {
+		if ethereumSigners[i], err = NewEthereumSigner(ctx, bytesSigner, chainID); err != nil {
+			return nil, fmt.Errorf("failed to build ethereum signer: %v", err)
+		}
+	}
+	return ethereumSigners, nil
+}
+
+type signerContextKey struct{}
+
+// contextWithSigner returns a context that makes txs built with it signed by `s`
+func contextWithSigner(ctx context.Context, s *EthereumSigner) context.Context {
+	return context.WithValue(ctx, signerContextKey{}, s)
+}
+
+// signerFor returns the signer bound to the context, or the primary signer if no signer is bound
+func (c *Chain) signerFor(ctx context.Context) *EthereumSigner {
+	if s, ok := ctx.Value(signerContextKey{}).(*EthereumSigner); ok {
+		return s
+	}
+	return &c.ethereumSigner
+}
+
+// nextSigner returns the signer of the next relayer account in round-robin
+func (c *Chain) nextSigner() *EthereumSigner {
+	if len(c.signers) == 0 {
+		return &c.ethereumSigner
+	}
+	i := c.signerCursor.Add(1) - 1
+	return c.signers[i%uint64(len(c.signers))]
+}
+
+// orderedBatchStarts returns the index of the MsgUpdateClient that starts the ordered batch of each msg, or -1 for independent msgs.
+// An ordered batch consists of a MsgUpdateClient and the following msgs up to the next MsgUpdateClient,
+// which are proven at the height updated by the MsgUpdateClient and so sent from one account.
+func orderedBatchStarts(msgs []sdk.Msg) []int {
+	starts := make([]int, len(msgs))
+	start := -1
+	for i, msg := range msgs {
+		if _, ok := msg.(*clienttypes.MsgUpdateClient); ok {
+			start = i
+		}
+		starts[i] = start
+	}
+	return starts
+}
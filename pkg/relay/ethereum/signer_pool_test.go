@@ -0,0 +1,121 @@
+package ethereum
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	chantypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/hyperledger-labs/yui-relayer/log"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNextSigner(t *testing.T) {
+	c := &Chain{ethereumSigner: EthereumSigner{addressCache: common.HexToAddress("0x01")}}
+	// the primary signer is used if the pool is empty
+	require.Equal(t, &c.ethereumSigner, c.nextSigner())
+
+	c.signers = []*EthereumSigner{
+		&c.ethereumSigner,
+		{addressCache: common.HexToAddress("0x02")},
+		{addressCache: common.HexToAddress("0x03")},
+	}
+	for i := 0; i < 2*len(c.signers); i++ {
+		require.Equal(t, c.signers[i%len(c.signers)], c.nextSigner())
+	}
+
+	ctx := context.Background()
+	require.Equal(t, &c.ethereumSigner, c.signerFor(ctx))
+	require.Equal(t, c.signers[2], c.signerFor(contextWithSigner(ctx, c.signers[2])))
+}
+
+func TestSignerPoolConfigValidate(t *testing.T) {
+	require.Error(t, (&SignerPoolConfig{}).Validate())
+}
+
+// signerPoolTestEthService counts the nonce of each account from the sent txs
+type signerPoolTestEthService struct {
+	broadcastTestEthService
+	t *testing.T
+}
+
+func (s *signerPoolTestEthService) GetTransactionCount(address common.Address, block string) (hexutil.Uint64, error) {
+	nonce := uint64(1)
+	for _, tx := range s.sent {
+		if s.sender(tx) == address {
+			nonce++
+		}
+	}
+	return hexutil.Uint64(nonce), nil
+}
+
+func (s *signerPoolTestEthService) GetTransactionReceipt(txHash common.Hash) (*gethtypes.Receipt, error) {
+	return &gethtypes.Receipt{
+		Status:      gethtypes.ReceiptStatusSuccessful,
+		TxHash:      txHash,
+		BlockNumber: big.NewInt(1),
+		GasUsed:     21000,
+		Logs:        []*gethtypes.Log{},
+	}, nil
+}
+
+func (s *signerPoolTestEthService) sender(tx *gethtypes.Transaction) common.Address {
+	sender, err := gethtypes.Sender(gethtypes.LatestSignerForChainID(tx.ChainId()), tx)
+	require.NoError(s.t, err)
+	return sender
+}
+
+func TestSendMsgsWithSignerPool(t *testing.T) {
+	if err := log.InitLogger("DEBUG", "text", "null", false); err != nil {
+		t.Fatal(err)
+	}
+	c := newAuditTestChain(t)
+	c.connectionOpenedConfirmed = true
+	for i := 0; i < 2; i++ {
+		s, err := NewEthereumSigner(context.Background(), newTestBytesSigner(t), c.chainID)
+		require.NoError(t, err)
+		c.signers = append(c.signers, s)
+	}
+	service := &signerPoolTestEthService{t: t}
+	withTestEthService(t, c, service)
+	// each msg is sent in its own tx
+	c.multicall3 = nil
+
+	// packets of another channel, which are not checked for redundancy
+	recvPacket := func(sequence uint64) sdk.Msg {
+		return &chantypes.MsgRecvPacket{Packet: chantypes.Packet{Sequence: sequence, DestinationPort: "transfer", DestinationChannel: "channel-1"}}
+	}
+	updateClient := &clienttypes.MsgUpdateClient{ClientId: "client-0", ClientMessage: &codectypes.Any{TypeUrl: "/test"}}
+	msgIDs, err := c.SendMsgs(context.Background(), []sdk.Msg{recvPacket(1), recvPacket(2), updateClient, recvPacket(3), recvPacket(4)})
+	require.NoError(t, err)
+	require.Len(t, msgIDs, 5)
+	require.Len(t, service.sent, 5)
+
+	// independent txs are spread across the accounts, and the ordered batch is sent from one account with consecutive nonces
+	expected := []struct {
+		signer *EthereumSigner
+		nonce  uint64
+	}{
+		{c.signers[0], 1},
+		{c.signers[1], 1},
+		{c.signers[2], 1},
+		{c.signers[2], 2},
+		{c.signers[2], 3},
+	}
+	for i, e := range expected {
+		require.Equal(t, e.signer.Address(), service.sender(service.sent[i]), i)
+		require.Equal(t, e.nonce, service.sent[i].Nonce(), i)
+	}
+
+	// the next call continues the round-robin
+	_, err = c.SendMsgs(context.Background(), []sdk.Msg{recvPacket(5)})
+	require.NoError(t, err)
+	require.Equal(t, c.signers[0].Address(), service.sender(service.sent[5]))
+	require.Equal(t, uint64(2), service.sent[5].Nonce())
+}
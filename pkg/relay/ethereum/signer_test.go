@@ -0,0 +1,34 @@
+package ethereum
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSignerFnContext(t *testing.T) {
+	s, err := NewEthereumSigner(context.Background(), newTestBytesSigner(t), big.NewInt(1))
+	require.NoError(t, err)
+	tx := gethtypes.NewTx(&gethtypes.LegacyTx{Nonce: 1, Gas: 21000, GasPrice: big.NewInt(1)})
+
+	signed, err := s.SignerFn(context.Background(), SignOptions{})(s.Address(), tx)
+	require.NoError(t, err)
+	sender, err := gethtypes.Sender(s.gethSigner, signed)
+	require.NoError(t, err)
+	require.Equal(t, s.Address(), sender)
+
+	// the cancellation of the context given to SignerFn reaches the underlying signer
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = s.SignerFn(ctx, SignOptions{})(s.Address(), tx)
+	require.True(t, errors.Is(err, context.Canceled), err)
+
+	// no signing with NoSign even if the context is canceled
+	unsigned, err := s.SignerFn(ctx, SignOptions{NoSign: true})(s.Address(), tx)
+	require.NoError(t, err)
+	require.Equal(t, tx.Hash(), unsigned.Hash())
+}
@@ -0,0 +1,194 @@
+package ethereum
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"path/filepath"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/hyperledger-labs/yui-relayer/log"
+)
+
+const topUpsFileName = "top_ups.json"
+
+// TopUp transfers funds from the treasury account to the relayer account bound to `ctx` so that its balance reaches the high watermark,
+// and waits for the top-up tx to be included.
+// If `force` is false, this does nothing unless the balance of the relayer account is below the low watermark.
+// Top-ups are rate-limited by `top_up.min_interval_sec` for each relayer account.
+func (c *Chain) TopUp(ctx context.Context, force bool) error {
+	return c.topUp(ctx, force, true)
+}
+
+// topUp is TopUp that waits for the receipt of the top-up tx in the background if `wait` is false,
+// in which case the relayer account is not topped up again until the tx is included.
+func (c *Chain) topUp(ctx context.Context, force bool, wait bool) error {
+	if c.treasurySigner == nil {
+		return fmt.Errorf("%w: top_up is not configured", ErrNotSupported)
+	}
+	c.topUpMu.Lock()
+	defer c.topUpMu.Unlock()
+
+	relayerAddr := c.signerFor(ctx).Address()
+	logger := c.GetChainLogger()
+	logger = &log.RelayLogger{Logger: logger.With(
+		logAttrTreasury, c.treasurySigner.Address().Hex(),
+		logAttrReceiver, relayerAddr.Hex(),
+	)}
+
+	if c.pendingTopUps[relayerAddr] {
+		logger.InfoContext(ctx, "skip top-up while the previous top-up tx is pending")
+		return nil
+	}
+
+	balance, err := c.client.BalanceAt(ctx, relayerAddr, nil)
+	if err != nil {
+		logger.ErrorContext(ctx, "failed to get balance", err)
+		return err
+	}
+	logger = &log.RelayLogger{Logger: logger.With(logAttrBalance, balance.String())}
+
+	if !force && balance.Cmp(c.config.TopUp.GetLowWatermark()) >= 0 {
+		return nil
+	}
+	amount := new(big.Int).Sub(c.config.TopUp.GetHighWatermark(), balance)
+	if amount.Sign() <= 0 {
+		logger.InfoContext(ctx, "skip top-up because the balance reaches the high watermark")
+		return nil
+	}
+	logger = &log.RelayLogger{Logger: logger.With(logAttrAmount, amount.String())}
+
+	// failed top-ups are also rate-limited not to waste the treasury funds on retries,
+	// while unsigned txs are not because they may never be sent
+	if err := c.reserveTopUp(relayerAddr, generateOnlyFrom(ctx) == nil); errors.Is(err, ErrTopUpRateLimited) {
+		logger.WarnContext(ctx, "skip top-up due to rate limit", "error", err)
+		return err
+	} else if err != nil {
+		logger.ErrorContext(ctx, "failed to check top-up rate limit", err)
+		return err
+	}
+
+	txOpts, err := c.treasuryTxOpts(ctx)
+	if err != nil {
+		logger.ErrorContext(ctx, "failed to build tx opts", err)
+		return err
+	}
+	txOpts.Value = amount
+
+	logger.InfoContext(ctx, "top up the relayer account")
+	tx, err := bind.NewBoundContract(relayerAddr, abi.ABI{}, nil, c.client, nil).Transfer(txOpts)
+	if err != nil {
+		logger.ErrorContext(ctx, "failed to send top-up tx", err)
+		return err
+	}
+	logger = &log.RelayLogger{Logger: logger.With(logAttrTxHash, tx.Hash())}
+
+	if g := generateOnlyFrom(ctx); g != nil {
+		if err := c.writeUnsignedTx(g, tx); err != nil {
+			logger.ErrorContext(ctx, "failed to write unsigned tx", err)
+			return err
+		}
+		return nil
+	}
+
+	if wait {
+		return c.waitForTopUp(ctx, logger, tx)
+	}
+	if c.pendingTopUps == nil {
+		c.pendingTopUps = make(map[common.Address]bool)
+	}
+	c.pendingTopUps[relayerAddr] = true
+	go func() {
+		// the result is logged in waitForTopUp
+		_ = c.waitForTopUp(context.WithoutCancel(ctx), logger, tx)
+
+		c.topUpMu.Lock()
+		defer c.topUpMu.Unlock()
+		delete(c.pendingTopUps, relayerAddr)
+	}()
+	return nil
+}
+
+// waitForTopUp waits for the receipt of the top-up tx and returns an error if it is not successful
+func (c *Chain) waitForTopUp(ctx context.Context, logger *log.RelayLogger, tx *gethtypes.Transaction) error {
+	receipt, err := c.client.WaitForReceiptAndGet(ctx, tx.Hash())
+	if err != nil {
+		logger.ErrorContext(ctx, "failed to wait for tx receipt", err)
+		return err
+	} else if receipt.Status == gethtypes.ReceiptStatusFailed {
+		err := errors.New("top-up tx failed")
+		logger.ErrorContext(ctx, "top-up tx failed", err)
+		return err
+	}
+	logger.InfoContext(ctx, "successfully topped up the relayer account")
+	return nil
+}
+
+// reserveTopUp returns an error wrapping ErrTopUpRateLimited if the relayer account was topped up within `top_up.min_interval_sec`,
+// and records the top-up otherwise if `record` is true.
+// The times of the last top-ups are kept in the data directory so that the rate limit is shared
+// by the relayer processes using the chain and survives restarts.
+func (c *Chain) reserveTopUp(relayerAddr common.Address, record bool) error {
+	dir, err := c.ensureDataDirectory()
+	if err != nil {
+		return err
+	}
+	interval := time.Duration(c.config.TopUp.MinIntervalSec) * time.Second
+	var (
+		lastTopUps map[common.Address]time.Time
+		limitErr   error
+	)
+	err = updateDataFile(filepath.Join(dir, topUpsFileName), &lastTopUps, func() bool {
+		if lastTopUp, ok := lastTopUps[relayerAddr]; ok && time.Since(lastTopUp) < interval {
+			limitErr = fmt.Errorf("%w: last_top_up=%s, min_interval=%s", ErrTopUpRateLimited, lastTopUp.Format(time.RFC3339), interval)
+			return false
+		} else if !record {
+			return false
+		}
+		if lastTopUps == nil {
+			lastTopUps = make(map[common.Address]time.Time)
+		}
+		lastTopUps[relayerAddr] = time.Now()
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	return limitErr
+}
+
+// treasuryTxOpts returns tx opts that send txs from the treasury account,
+// or build unsigned txs from it (or the address given by `--from`) in generate-only mode
+func (c *Chain) treasuryTxOpts(ctx context.Context) (*bind.TransactOpts, error) {
+	addr := c.treasurySigner.Address()
+	if g := generateOnlyFrom(ctx); g != nil {
+		if g.from == (common.Address{}) {
+			g.from = addr
+		}
+		return c.generateOnlyTxOpts(ctx, g)
+	}
+
+	txOpts := &bind.TransactOpts{
+		From:    addr,
+		Signer:  c.treasurySigner.SignerFn(ctx, SignOptions{}),
+		Context: ctx,
+	}
+
+	// the pending nonce is used not to replace the top-up txs waited for in the background
+	if nonce, err := c.client.PendingNonceAt(ctx, addr); err != nil {
+		return nil, err
+	} else {
+		txOpts.Nonce = new(big.Int).SetUint64(nonce)
+	}
+
+	if err := NewGasFeeCalculator(c.client, &c.config).Apply(ctx, txOpts); err != nil {
+		return nil, err
+	}
+
+	return txOpts, nil
+}
@@ -0,0 +1,118 @@
+package ethereum
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/hyperledger-labs/yui-relayer/log"
+	"github.com/stretchr/testify/require"
+)
+
+// topUpTestEthService reports `balance` for every account and includes the sent txs with `status`
+// once `included` is closed, or immediately if it is nil
+type topUpTestEthService struct {
+	broadcastTestEthService
+	balance  *big.Int
+	status   uint64
+	included chan struct{}
+}
+
+func (s *topUpTestEthService) GetBalance(address common.Address, block string) (*hexutil.Big, error) {
+	return (*hexutil.Big)(s.balance), nil
+}
+
+func (s *topUpTestEthService) GetTransactionReceipt(txHash common.Hash) (*gethtypes.Receipt, error) {
+	if s.included != nil {
+		<-s.included
+	}
+	return &gethtypes.Receipt{Status: s.status, TxHash: txHash, GasUsed: 21000, Logs: []*gethtypes.Log{}}, nil
+}
+
+func newTopUpTestChain(t *testing.T, service *topUpTestEthService) *Chain {
+	c := newAuditTestChain(t)
+	treasurySigner, err := NewEthereumSigner(context.Background(), newTestBytesSigner(t), c.chainID)
+	require.NoError(t, err)
+	c.treasurySigner = treasurySigner
+	c.config.TopUp = &TopUpConfig{LowWatermark: "100wei", HighWatermark: "1000wei", MinIntervalSec: 3600}
+	withTestEthService(t, c, service)
+	return c
+}
+
+func TestTopUp(t *testing.T) {
+	if err := log.InitLogger("DEBUG", "text", "null", false); err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	service := &topUpTestEthService{balance: big.NewInt(100), status: gethtypes.ReceiptStatusSuccessful}
+	c := newTopUpTestChain(t, service)
+
+	// nothing is sent while the balance is not below the low watermark
+	require.NoError(t, c.TopUp(ctx, false))
+	require.Empty(t, service.sent)
+
+	// the relayer account is topped up to the high watermark from the treasury account
+	service.balance = big.NewInt(99)
+	require.NoError(t, c.TopUp(ctx, false))
+	require.Len(t, service.sent, 1)
+	tx := service.sent[0]
+	require.Equal(t, c.ethereumSigner.Address(), *tx.To())
+	require.Equal(t, big.NewInt(901), tx.Value())
+	sender, err := gethtypes.Sender(c.treasurySigner.gethSigner, tx)
+	require.NoError(t, err)
+	require.Equal(t, c.treasurySigner.Address(), sender)
+
+	// the rate limit is shared with the relayer processes using the chain, and applies to forced top-ups
+	other := newTopUpTestChain(t, service)
+	other.homePath = c.homePath
+	other.ethereumSigner = c.ethereumSigner
+	other.signers = []*EthereumSigner{&other.ethereumSigner}
+	require.ErrorIs(t, other.TopUp(ctx, true), ErrTopUpRateLimited)
+	require.ErrorIs(t, c.TopUp(ctx, true), ErrTopUpRateLimited)
+	require.Len(t, service.sent, 1)
+
+	// a forced top-up is sent even if the balance is not below the low watermark
+	c.config.TopUp.MinIntervalSec = 0
+	service.balance = big.NewInt(500)
+	require.NoError(t, c.TopUp(ctx, true))
+	require.Len(t, service.sent, 2)
+	require.Equal(t, big.NewInt(500), service.sent[1].Value())
+
+	// the failure of the top-up tx is returned, and the failed top-up is rate-limited as well
+	service.status = gethtypes.ReceiptStatusFailed
+	c2 := newTopUpTestChain(t, service)
+	require.Error(t, c2.TopUp(ctx, true))
+	require.Len(t, service.sent, 3)
+	require.ErrorIs(t, c2.TopUp(ctx, true), ErrTopUpRateLimited)
+}
+
+func TestTopUpInBackground(t *testing.T) {
+	if err := log.InitLogger("DEBUG", "text", "null", false); err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	service := &topUpTestEthService{balance: big.NewInt(99), status: gethtypes.ReceiptStatusSuccessful, included: make(chan struct{})}
+	c := newTopUpTestChain(t, service)
+	c.config.TopUp.MinIntervalSec = 0
+
+	// the top-up tx is sent without waiting for its receipt
+	require.NoError(t, c.topUp(ctx, false, false))
+	require.Len(t, service.sent, 1)
+
+	// the relayer account is not topped up again while the tx is pending
+	require.NoError(t, c.topUp(ctx, false, false))
+	require.Len(t, service.sent, 1)
+
+	close(service.included)
+	require.Eventually(t, func() bool {
+		c.topUpMu.Lock()
+		defer c.topUpMu.Unlock()
+		return len(c.pendingTopUps) == 0
+	}, 5*time.Second, 10*time.Millisecond)
+	require.NoError(t, c.topUp(ctx, false, false))
+	require.Len(t, service.sent, 2)
+}
@@ -0,0 +1,156 @@
+package ethereum
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/hyperledger-labs/yui-relayer/log"
+
+	"github.com/datachainlab/ethereum-ibc-relay-chain/pkg/contract/ierc20"
+	"github.com/datachainlab/ethereum-ibc-relay-chain/pkg/contract/iics20transfer"
+)
+
+// TxTransfer builds a tx that calls `sendTransfer` of the ICS-20 app bound to the source port.
+// If the denom is the address of an ERC-20 token, the app must be approved to spend the amount beforehand.
+func (c *Chain) TxTransfer(opts *bind.TransactOpts, msg *transfertypes.MsgTransfer) (*gethtypes.Transaction, error) {
+	if err := validateTransferTimeout(msg); err != nil {
+		return nil, err
+	}
+	amount := msg.Token.Amount.BigInt()
+
+	appAddr, err := c.ibcHandler.GetIBCModuleByPort(c.CallOpts(opts.Context, 0), msg.SourcePort)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get ICS-20 app: %w", err)
+	}
+
+	// the allowance is not checked for unsigned txs because it may be granted by an approve tx generated before them
+	if common.IsHexAddress(msg.Token.Denom) && generateOnlyFrom(opts.Context) == nil {
+		allowance, err := c.erc20Allowance(opts.Context, common.HexToAddress(msg.Token.Denom), opts.From, appAddr)
+		if err != nil {
+			return nil, err
+		} else if allowance.Cmp(amount) < 0 {
+			return nil, fmt.Errorf("insufficient allowance for the ICS-20 app: token=%s, allowance=%v, amount=%v", msg.Token.Denom, allowance, amount)
+		}
+	}
+
+	app, err := iics20transfer.NewIics20transfer(appAddr, c.client)
+	if err != nil {
+		return nil, err
+	}
+	return app.SendTransfer(
+		opts,
+		msg.Token.Denom,
+		amount,
+		msg.Receiver,
+		msg.SourcePort,
+		msg.SourceChannel,
+		msg.TimeoutHeight.GetRevisionHeight(),
+	)
+}
+
+// validateTransferTimeout returns an error if the timeout of the msg cannot be represented by the ICS-20 app,
+// which only takes the revision height of the timeout height
+func validateTransferTimeout(msg *transfertypes.MsgTransfer) error {
+	if msg.TimeoutHeight.IsZero() {
+		return errors.New("timeout height must be set because the ICS-20 app doesn't support timeout timestamp")
+	} else if msg.TimeoutTimestamp != 0 {
+		return errors.New("timeout timestamp must be zero because the ICS-20 app doesn't support timeout timestamp")
+	} else if msg.TimeoutHeight.RevisionNumber != 0 {
+		return fmt.Errorf("revision number of timeout height must be zero because the ICS-20 app only takes the revision height: %v", msg.TimeoutHeight)
+	}
+	return nil
+}
+
+// Transfer sends a transfer packet through the ICS-20 app.
+// If the denom is the address of an ERC-20 token, this approves the app to spend the amount if necessary.
+func (c *Chain) Transfer(ctx context.Context, msg *transfertypes.MsgTransfer) error {
+	logger := c.GetChainLogger()
+	logger = &log.RelayLogger{Logger: logger.With(
+		logAttrPortID, msg.SourcePort,
+		logAttrChannelID, msg.SourceChannel,
+		logAttrDenom, msg.Token.Denom,
+		logAttrAmount, msg.Token.Amount.String(),
+		logAttrReceiver, msg.Receiver,
+	)}
+
+	if err := validateTransferTimeout(msg); err != nil {
+		logger.ErrorContext(ctx, "invalid transfer timeout", err)
+		return err
+	}
+	var approved bool
+	if common.IsHexAddress(msg.Token.Denom) {
+		appAddr, err := c.ibcHandler.GetIBCModuleByPort(c.CallOpts(ctx, 0), msg.SourcePort)
+		if err != nil {
+			logger.ErrorContext(ctx, "failed to get ICS-20 app", err)
+			return err
+		}
+		if approved, err = c.approveERC20(ctx, logger, common.HexToAddress(msg.Token.Denom), appAddr, msg.Token.Amount.BigInt()); err != nil {
+			return err
+		}
+	}
+
+	txOpts, err := c.adminTxOpts(ctx)
+	if err != nil {
+		return err
+	}
+	// the transfer cannot be estimated until the unsigned approve tx is included, which reverts without the allowance
+	if approved && generateOnlyFrom(ctx) != nil {
+		txOpts.GasLimit = c.config.MaxGasLimit
+	}
+
+	tx, err := c.TxTransfer(txOpts, msg)
+
+	return processSendTxResult(ctx, logger, c, tx, err)
+}
+
+func (c *Chain) erc20Allowance(ctx context.Context, token common.Address, owner common.Address, spender common.Address) (*big.Int, error) {
+	erc20, err := ierc20.NewIerc20(token, c.client)
+	if err != nil {
+		return nil, err
+	}
+	allowance, err := erc20.Allowance(c.CallOpts(ctx, 0), owner, spender)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get allowance: %w", err)
+	}
+	return allowance, nil
+}
+
+// approveERC20 approves `spender` to spend `amount` of the token if the current allowance is insufficient,
+// and returns true if the approve tx is sent (or generated with `--generate-only`)
+func (c *Chain) approveERC20(ctx context.Context, logger *log.RelayLogger, token common.Address, spender common.Address, amount *big.Int) (bool, error) {
+	owner := c.signerFor(ctx).Address()
+	if g := generateOnlyFrom(ctx); g != nil && g.from != (common.Address{}) {
+		owner = g.from
+	}
+	allowance, err := c.erc20Allowance(ctx, token, owner, spender)
+	if err != nil {
+		logger.ErrorContext(ctx, "failed to get allowance", err)
+		return false, err
+	} else if allowance.Cmp(amount) >= 0 {
+		return false, nil
+	}
+
+	erc20, err := ierc20.NewIerc20(token, c.client)
+	if err != nil {
+		return false, err
+	}
+
+	txOpts, err := c.adminTxOpts(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	logger.InfoContext(ctx, "approve the ICS-20 app to spend the token", logAttrAllowance, allowance.String())
+	tx, err := erc20.Approve(txOpts, spender, amount)
+
+	if err := processSendTxResult(ctx, logger, c, tx, err); err != nil {
+		return false, err
+	}
+	return true, nil
+}
@@ -0,0 +1,163 @@
+package ethereum
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/hyperledger-labs/yui-relayer/log"
+	"github.com/stretchr/testify/require"
+
+	"github.com/datachainlab/ethereum-ibc-relay-chain/pkg/contract/ibchandler"
+	"github.com/datachainlab/ethereum-ibc-relay-chain/pkg/contract/ierc20"
+	"github.com/datachainlab/ethereum-ibc-relay-chain/pkg/contract/iics20transfer"
+)
+
+// transferTestEthService binds the ICS-20 app at `app` to every port,
+// and reports the allowance of the ERC-20 tokens approved by the sent txs
+type transferTestEthService struct {
+	broadcastTestEthService
+	t   *testing.T
+	app common.Address
+}
+
+func (s *transferTestEthService) GetTransactionCount(address common.Address, block string) (hexutil.Uint64, error) {
+	return hexutil.Uint64(1 + len(s.sent)), nil
+}
+
+func (s *transferTestEthService) Call(args map[string]interface{}, block string) (hexutil.Bytes, error) {
+	to := common.HexToAddress(args["to"].(string))
+	input, err := hexutil.Decode(args["input"].(string))
+	require.NoError(s.t, err)
+
+	if to == common.HexToAddress("0x01") {
+		ibcHandlerABI, err := ibchandler.IbchandlerMetaData.GetAbi()
+		require.NoError(s.t, err)
+		method, err := ibcHandlerABI.MethodById(input[:4])
+		require.NoError(s.t, err)
+		require.Equal(s.t, "getIBCModuleByPort", method.Name)
+		return method.Outputs.Pack(s.app)
+	}
+
+	erc20ABI, err := ierc20.Ierc20MetaData.GetAbi()
+	require.NoError(s.t, err)
+	method, err := erc20ABI.MethodById(input[:4])
+	require.NoError(s.t, err)
+	require.Equal(s.t, "allowance", method.Name)
+	allowance := big.NewInt(0)
+	for _, tx := range s.sent {
+		if *tx.To() == to {
+			args, err := erc20ABI.Methods["approve"].Inputs.Unpack(tx.Data()[4:])
+			require.NoError(s.t, err)
+			allowance = args[1].(*big.Int)
+		}
+	}
+	return method.Outputs.Pack(allowance)
+}
+
+func TestTransfer(t *testing.T) {
+	if err := log.InitLogger("DEBUG", "text", "null", false); err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	service := &transferTestEthService{t: t, app: common.HexToAddress("0x30")}
+	c := newTestChain(t)
+	withTestEthService(t, c, service)
+	erc20ABI, err := ierc20.Ierc20MetaData.GetAbi()
+	require.NoError(t, err)
+	ics20ABI, err := iics20transfer.Iics20transferMetaData.GetAbi()
+	require.NoError(t, err)
+
+	token := common.HexToAddress("0x10")
+	msg := &transfertypes.MsgTransfer{
+		SourcePort:    "transfer",
+		SourceChannel: "channel-0",
+		Token:         sdk.Coin{Denom: token.Hex(), Amount: sdkmath.NewInt(100)},
+		Receiver:      "receiver",
+		TimeoutHeight: clienttypes.NewHeight(0, 1000),
+	}
+
+	// the app is approved to spend the amount before the transfer
+	require.NoError(t, c.Transfer(ctx, msg))
+	require.Len(t, service.sent, 2)
+	approve, transfer := service.sent[0], service.sent[1]
+	require.Equal(t, token, *approve.To())
+	require.Equal(t, uint64(1), approve.Nonce())
+	method, err := erc20ABI.MethodById(approve.Data()[:4])
+	require.NoError(t, err)
+	require.Equal(t, "approve", method.Name)
+	args, err := method.Inputs.Unpack(approve.Data()[4:])
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{service.app, big.NewInt(100)}, args)
+
+	require.Equal(t, service.app, *transfer.To())
+	require.Equal(t, uint64(2), transfer.Nonce())
+	method, err = ics20ABI.MethodById(transfer.Data()[:4])
+	require.NoError(t, err)
+	require.Equal(t, "sendTransfer", method.Name)
+	args, err = method.Inputs.Unpack(transfer.Data()[4:])
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{token.Hex(), big.NewInt(100), "receiver", "transfer", "channel-0", uint64(1000)}, args)
+
+	// the approval is skipped if the allowance is sufficient
+	require.NoError(t, c.Transfer(ctx, msg))
+	require.Len(t, service.sent, 3)
+	require.Equal(t, service.app, *service.sent[2].To())
+
+	// timeouts that the app cannot represent are rejected before the approval of another token
+	for _, invalid := range []func(msg *transfertypes.MsgTransfer){
+		func(msg *transfertypes.MsgTransfer) { msg.TimeoutTimestamp = 1 },
+		func(msg *transfertypes.MsgTransfer) { msg.TimeoutHeight = clienttypes.NewHeight(1, 1000) },
+	} {
+		invalidMsg := *msg
+		invalidMsg.Token.Denom = common.HexToAddress("0x20").Hex()
+		invalid(&invalidMsg)
+		require.Error(t, c.Transfer(ctx, &invalidMsg))
+	}
+	require.Len(t, service.sent, 3)
+}
+
+func TestTransferGenerateOnly(t *testing.T) {
+	if err := log.InitLogger("DEBUG", "text", "null", false); err != nil {
+		t.Fatal(err)
+	}
+	service := &transferTestEthService{t: t, app: common.HexToAddress("0x30")}
+	c := newTestChain(t)
+	withTestEthService(t, c, service)
+
+	token := common.HexToAddress("0x10")
+	var out bytes.Buffer
+	ctx := contextWithGenerateOnly(context.Background(), &generateOnly{out: &out})
+	require.NoError(t, c.Transfer(ctx, &transfertypes.MsgTransfer{
+		SourcePort:    "transfer",
+		SourceChannel: "channel-0",
+		Token:         sdk.Coin{Denom: token.Hex(), Amount: sdkmath.NewInt(100)},
+		Receiver:      "receiver",
+		TimeoutHeight: clienttypes.NewHeight(0, 1000),
+	}))
+	require.Empty(t, service.sent)
+
+	// the approve tx and the transfer tx are generated with consecutive nonces
+	dec := json.NewDecoder(&out)
+	var unsignedTxs []txArgs
+	for dec.More() {
+		var args txArgs
+		require.NoError(t, dec.Decode(&args))
+		unsignedTxs = append(unsignedTxs, args)
+	}
+	require.Len(t, unsignedTxs, 2)
+	require.Equal(t, token, *unsignedTxs[0].To)
+	require.Equal(t, uint64(1), uint64(unsignedTxs[0].Nonce))
+	require.Equal(t, service.app, *unsignedTxs[1].To)
+	require.Equal(t, uint64(2), uint64(unsignedTxs[1].Nonce))
+	// the transfer cannot be estimated before the approval
+	require.Equal(t, c.config.MaxGasLimit, uint64(unsignedTxs[1].Gas))
+}
@@ -6,12 +6,14 @@ import (
 	"errors"
 	"fmt"
 	math "math"
+	"math/big"
 	"slices"
 	"sort"
 	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/gogoproto/proto"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
 	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
 	conntypes "github.com/cosmos/ibc-go/v8/modules/core/03-connection/types"
 	chantypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
@@ -39,17 +41,69 @@ func (c *Chain) SendMsgs(ctx context.Context, msgs []sdk.Msg) ([]core.MsgID, err
 		return nil, fmt.Errorf("failed to confirm connection opened: %w", err)
 	}
 
+	// the relayer accounts are rotated only between calls so that they are not replaced while txs are sent
+	if err := c.rotateSignerIfRequested(ctx); err != nil {
+		// msgs are sent with the current accounts in this case, and the rotation is retried in the next call
+		c.GetChainLogger().ErrorContext(ctx, "failed to rotate signer", err)
+	}
+	c.signerMu.RLock()
+	defer c.signerMu.RUnlock()
+
 	logger := c.GetChainLogger()
-	ethereumSignerLogger := c.ethereumSigner.GetLogger()
-	defer c.ethereumSigner.SetLogger(ethereumSignerLogger)
+
+	// drop msgs that have already been processed on the chain (e.g. by another relayer)
+	redundant, err := c.findRedundantMsgs(ctx, msgs)
+	if err != nil {
+		// the check is best-effort, so all msgs are sent in this case
+		logger.ErrorContext(ctx, "failed to check redundant msgs", err)
+		redundant = make([]bool, len(msgs))
+	}
+	for i, r := range redundant {
+		if r {
+			logger.InfoContext(ctx, "skip msg already processed on chain", logAttrMsgIndex, i, logAttrMsgType, fmt.Sprintf("%T", msgs[i]))
+		}
+	}
+	msgs = filterMsgs(msgs, redundant)
 
 	var msgIDs []core.MsgID
 
+	// independent txs are sent from the relayer accounts in round-robin,
+	// while the txs of an ordered batch are sent from the account that sent its MsgUpdateClient
+	batchStarts := orderedBatchStarts(msgs)
+	batchSigners := make(map[int]*EthereumSigner)
+	toppedUp := make(map[*EthereumSigner]bool)
+
 	iter := NewCallIter(msgs, skipUpdateClientCommitment)
+	if c.config.hasUrgencyTiers() {
+		if iter.blocksToTimeout, err = c.msgsBlocksToTimeout(ctx, msgs); err != nil {
+			// msgs are sent with the normal fees in this case
+			logger.ErrorContext(ctx, "failed to get blocks to timeout", err)
+		}
+	}
 	for !iter.End() {
 		from := iter.Cursor()
-		logger := &log.RelayLogger{Logger: logger.With(logAttrMsgIndexFrom, from)}
-		c.ethereumSigner.SetLogger(logger)
+
+		signer, ok := batchSigners[batchStarts[from]]
+		if !ok {
+			signer = c.nextSigner()
+		}
+		ctx := contextWithSigner(ctx, signer)
+		logger := &log.RelayLogger{Logger: logger.With(
+			logAttrSigner, signer.Address().Hex(),
+			logAttrMsgIndexFrom, from,
+		)}
+
+		if c.treasurySigner != nil && !toppedUp[signer] {
+			// failures are logged in topUp and don't prevent msgs from being sent,
+			// and the top-up tx is waited for in the background not to delay the msgs
+			_ = c.topUp(ctx, false, false)
+			toppedUp[signer] = true
+		}
+
+		if err := c.checkSpendingLimits(ctx); err != nil {
+			logger.ErrorContext(ctx, "refuse to send tx", err)
+			return nil, err
+		}
 
 		built, err := iter.BuildTx(ctx, c)
 
@@ -59,12 +113,20 @@ func (c *Chain) SendMsgs(ctx context.Context, msgs []sdk.Msg) ([]core.MsgID, err
 		} else if built == nil {
 			break
 		} else {
+			for i := from; i < from+built.count; i++ {
+				if batchStarts[i] == i {
+					batchSigners[i] = signer
+				}
+			}
 			trace.SpanFromContext(ctx).SetAttributes(semconv.TxHashKey.String(built.tx.Hash().String()))
 			logger = iter.updateLoggerMessageInfo(logger, from, built.count)
 			logger = &log.RelayLogger{Logger: logger.With(
 				logAttrTxHash, built.tx.Hash(),
 				logAttrTxSize, built.tx.Size(),
 			)}
+			if built.l1Fee != nil {
+				logger = &log.RelayLogger{Logger: logger.With(logAttrL1Fee, built.l1Fee.String())}
+			}
 		}
 
 		if rawTxData, err := built.tx.MarshalBinary(); err != nil {
@@ -84,6 +146,8 @@ func (c *Chain) SendMsgs(ctx context.Context, msgs []sdk.Msg) ([]core.MsgID, err
 			logger.ErrorContext(ctx, "failed to get receipt", err)
 			return nil, err
 		} else {
+			c.recordTxFee(ctx, &receipt.Receipt)
+			c.recordL1Fee(ctx, built.l1Fee)
 			logger = &log.RelayLogger{Logger: logger.With(
 				logAttrBlockHash, receipt.BlockHash,
 				logAttrBlockNumber, receipt.BlockNumber.Uint64(),
@@ -122,7 +186,7 @@ func (c *Chain) SendMsgs(ctx context.Context, msgs []sdk.Msg) ([]core.MsgID, err
 		}
 		iter.Next(built.count)
 	}
-	return msgIDs, nil
+	return mergeNoopMsgIDs(redundant, msgIDs), nil
 }
 
 func (c *Chain) GetMsgResult(ctx context.Context, id core.MsgID) (core.MsgResult, error) {
@@ -132,6 +196,10 @@ func (c *Chain) GetMsgResult(ctx context.Context, id core.MsgID) (core.MsgResult
 	if !ok {
 		return nil, fmt.Errorf("unexpected message id type: %T", id)
 	}
+	if msgID.IsNoop() {
+		// the msg was not sent because it had already been processed on the chain
+		return &MsgResult{status: true}, nil
+	}
 	txHash := msgID.TxHash()
 	trace.SpanFromContext(ctx).SetAttributes(semconv.TxHashKey.String(txHash.String()))
 	receipt, err := c.client.WaitForReceiptAndGet(ctx, txHash)
@@ -375,6 +443,42 @@ func (c *Chain) TxTimeout(opts *bind.TransactOpts, msg *chantypes.MsgTimeout) (*
 	})
 }
 
+func (c *Chain) TxTimeoutOnClose(opts *bind.TransactOpts, msg *chantypes.MsgTimeoutOnClose) (*gethtypes.Transaction, error) {
+	return c.ibcHandler.TimeoutOnClose(opts, ibchandler.IIBCChannelPacketTimeoutMsgTimeoutOnClose{
+		Packet: ibchandler.Packet{
+			Sequence:           msg.Packet.Sequence,
+			SourcePort:         msg.Packet.SourcePort,
+			SourceChannel:      msg.Packet.SourceChannel,
+			DestinationPort:    msg.Packet.DestinationPort,
+			DestinationChannel: msg.Packet.DestinationChannel,
+			Data:               msg.Packet.Data,
+			TimeoutHeight:      ibchandler.HeightData(msg.Packet.TimeoutHeight),
+			TimeoutTimestamp:   msg.Packet.TimeoutTimestamp,
+		},
+		ProofUnreceived:             msg.ProofUnreceived,
+		ProofClose:                  msg.ProofClose,
+		ProofHeight:                 pbToHandlerHeight(msg.ProofHeight),
+		NextSequenceRecv:            msg.NextSequenceRecv,
+		CounterpartyUpgradeSequence: msg.CounterpartyUpgradeSequence,
+	})
+}
+
+func (c *Chain) TxChannelCloseInit(opts *bind.TransactOpts, msg *chantypes.MsgChannelCloseInit) (*gethtypes.Transaction, error) {
+	return c.ibcHandler.ChannelCloseInit(opts, ibchandler.IIBCChannelHandshakeMsgChannelCloseInit{
+		PortId:    msg.PortId,
+		ChannelId: msg.ChannelId,
+	})
+}
+
+func (c *Chain) TxChannelCloseConfirm(opts *bind.TransactOpts, msg *chantypes.MsgChannelCloseConfirm) (*gethtypes.Transaction, error) {
+	return c.ibcHandler.ChannelCloseConfirm(opts, ibchandler.IIBCChannelHandshakeMsgChannelCloseConfirm{
+		PortId:      msg.PortId,
+		ChannelId:   msg.ChannelId,
+		ProofInit:   msg.ProofInit,
+		ProofHeight: pbToHandlerHeight(msg.ProofHeight),
+	})
+}
+
 func (c *Chain) TxChannelUpgradeInit(opts *bind.TransactOpts, msg *chantypes.MsgChannelUpgradeInit) (*gethtypes.Transaction, error) {
 	return c.ibcHandler.ChannelUpgradeInit(opts, ibchandler.IIBCChannelUpgradeBaseMsgChannelUpgradeInit{
 		PortId:                c.pathEnd.PortID,
@@ -489,6 +593,12 @@ func (c *Chain) BuildMessageTx(opts *bind.TransactOpts, msg sdk.Msg, skipUpdateC
 		tx, err = c.TxAcknowledgement(opts, msg)
 	case *chantypes.MsgTimeout:
 		tx, err = c.TxTimeout(opts, msg)
+	case *chantypes.MsgTimeoutOnClose:
+		tx, err = c.TxTimeoutOnClose(opts, msg)
+	case *chantypes.MsgChannelCloseInit:
+		tx, err = c.TxChannelCloseInit(opts, msg)
+	case *chantypes.MsgChannelCloseConfirm:
+		tx, err = c.TxChannelCloseConfirm(opts, msg)
 	case *chantypes.MsgChannelUpgradeInit:
 		tx, err = c.TxChannelUpgradeInit(opts, msg)
 	case *chantypes.MsgChannelUpgradeTry:
@@ -503,11 +613,11 @@ func (c *Chain) BuildMessageTx(opts *bind.TransactOpts, msg sdk.Msg, skipUpdateC
 		tx, err = c.TxChannelUpgradeCancel(opts, msg)
 	case *chantypes.MsgChannelUpgradeTimeout:
 		tx, err = c.TxChannelUpgradeTimeout(opts, msg)
-	// case *transfertypes.MsgTransfer:
-	// 	err = c.client.transfer(msg)
+	case *transfertypes.MsgTransfer:
+		tx, err = c.TxTransfer(opts, msg)
 	default:
-		logger.Error("failed to send msg", errors.New("illegal msg type"), "msg", msg)
-		panic("illegal msg type")
+		err = fmt.Errorf("%w: %T", ErrUnsupportedMsg, msg)
+		logger.Error("failed to build msg tx", err, "msg", msg)
 	}
 	return tx, err
 }
@@ -574,7 +684,7 @@ func estimateGas(
 		logger = &log.RelayLogger{Logger: logger.With(logAttrRawTxData, hex.EncodeToString(rawTxData))}
 	}
 
-	estimatedGas, err := c.client.EstimateGasFromTx(ctx, tx, c.ethereumSigner.Address(), c.Config().EstimateGasCap)
+	estimatedGas, err := c.client.EstimateGasFromTx(ctx, tx, c.signerFor(ctx).Address(), c.Config().EstimateGasCap)
 	if err != nil {
 		if revertReason, rawErrorData, err := c.getRevertReasonFromRpcError(err); err != nil {
 			// Raw error data may be available even if revert reason isn't available.
@@ -614,6 +724,8 @@ type CallIter struct {
 	// for multicall
 	txs          []gethtypes.Transaction
 	msgTypeNames []string
+	// number of blocks remaining before timeout for each msg, which is nil if the fees are not scaled by urgency
+	blocksToTimeout []uint64
 }
 
 func NewCallIter(msgs []sdk.Msg, skipUpdateClientCommitment bool) CallIter {
@@ -660,6 +772,8 @@ func (iter *CallIter) updateLoggerMessageInfo(logger *log.RelayLogger, from int,
 type CallIterBuildResult struct {
 	tx    *gethtypes.Transaction
 	count int
+	// L1 data fee of the tx, which is nil if `l2_fee_model` is not configured
+	l1Fee *big.Int
 }
 
 func (iter *CallIter) BuildTx(ctx context.Context, c *Chain) (*CallIterBuildResult, error) {
@@ -677,19 +791,25 @@ func (iter *CallIter) buildSingleTx(ctx context.Context, c *Chain) (*CallIterBui
 
 	logger := c.GetChainLogger()
 	logger = iter.updateLoggerMessageInfo(logger, iter.Cursor(), 1)
+	if blocks, ok := iter.blocksToTimeoutOf(iter.Cursor(), iter.Cursor()+1); ok {
+		logger = &log.RelayLogger{Logger: logger.With(logAttrBlocksToTimeout, blocks)}
+	}
 
-	opts, err := c.TxOpts(ctx, true)
+	signer := c.signerFor(ctx)
+	opts, err := c.TxOpts(iter.urgencyContext(ctx, iter.Cursor(), iter.Cursor()+1), true)
 	if err != nil {
 		return nil, err
 	}
 	opts.NoSend = true
 
 	// gas estimation
+	var (
+		accessList gethtypes.AccessList
+		l1Fee      *big.Int
+	)
 	{
 		opts.GasLimit = math.MaxUint64
-		c.ethereumSigner.NoSign = true
-		tx, err := c.BuildMessageTx(opts, iter.Current(), iter.skipUpdateClientCommitment)
-		c.ethereumSigner.NoSign = false
+		tx, err := c.BuildMessageTx(withSignOptions(opts, signer, SignOptions{NoSign: true}), iter.Current(), iter.skipUpdateClientCommitment)
 		if err != nil {
 			logger.ErrorContext(ctx, "failed to build tx for gas estimation", err)
 			return nil, err
@@ -699,16 +819,25 @@ func (iter *CallIter) buildSingleTx(ctx context.Context, c *Chain) (*CallIterBui
 		if err != nil {
 			return nil, err
 		}
-		opts.GasLimit = txGasLimit
+		accessList, opts.GasLimit = createAccessList(ctx, c, tx, txGasLimit, logger)
+
+		if l1Fee, err = estimateL1Fee(ctx, c, tx, true, logger); err != nil {
+			return nil, err
+		}
 	}
 
-	tx, err := c.BuildMessageTx(opts, iter.Current(), iter.skipUpdateClientCommitment)
+	signOpts := SignOptions{
+		Logger:   logger,
+		MsgTypes: iter.msgTypeNames[iter.Cursor() : iter.Cursor()+1],
+		Path:     c.pathEnd,
+	}
+	tx, err := c.BuildMessageTx(withAccessList(withSignOptions(opts, signer, signOpts), accessList, c.chainID), iter.Current(), iter.skipUpdateClientCommitment)
 	if err != nil {
 		logger.ErrorContext(ctx, "failed to build tx", err)
 		return nil, err
 	}
 
-	return &CallIterBuildResult{tx, 1}, nil
+	return &CallIterBuildResult{tx, 1, l1Fee}, nil
 }
 
 func (iter *CallIter) buildMultiTx(ctx context.Context, c *Chain) (*CallIterBuildResult, error) {
@@ -719,12 +848,15 @@ func (iter *CallIter) buildMultiTx(ctx context.Context, c *Chain) (*CallIterBuil
 
 	logger := c.GetChainLogger()
 
-	opts, err := c.TxOpts(ctx, true)
+	signer := c.signerFor(ctx)
+	// the fees are scaled by the earliest timeout of the remaining msgs, and recalculated below if the tx contains only some of them
+	opts, err := c.TxOpts(iter.urgencyContext(ctx, iter.Cursor(), len(iter.msgs)), true)
 	if err != nil {
 		return nil, err
 	}
 	opts.NoSend = true
 	opts.GasLimit = math.MaxUint64
+	noSignOpts := withSignOptions(opts, signer, SignOptions{NoSign: true})
 
 	if iter.txs == nil { // create txs at first multicall call
 		txs := make([]gethtypes.Transaction, 0, len(iter.msgs))
@@ -733,9 +865,7 @@ func (iter *CallIter) buildMultiTx(ctx context.Context, c *Chain) (*CallIterBuil
 			logger := iter.updateLoggerMessageInfo(logger, i, 1)
 
 			// note that its nonce is not checked
-			c.ethereumSigner.NoSign = true
-			tx, err := c.BuildMessageTx(opts, iter.msgs[i], iter.skipUpdateClientCommitment)
-			c.ethereumSigner.NoSign = false
+			tx, err := c.BuildMessageTx(noSignOpts, iter.msgs[i], iter.skipUpdateClientCommitment)
 			if err != nil {
 				logger.ErrorContext(ctx, "failed to build tx for gas estimation", err)
 				return nil, err
@@ -753,6 +883,8 @@ func (iter *CallIter) buildMultiTx(ctx context.Context, c *Chain) (*CallIterBuil
 	var (
 		lastOkCalls    []multicall3.Multicall3Call = nil
 		lastOkGasLimit uint64                      = 0
+		lastOkL1Fee    *big.Int                    = nil
+		lastOkTx       *gethtypes.Transaction      = nil
 	)
 	count, err := findItems(
 		len(iter.msgs)-iter.Cursor(),
@@ -770,9 +902,7 @@ func (iter *CallIter) buildMultiTx(ctx context.Context, c *Chain) (*CallIterBuil
 				})
 			}
 
-			c.ethereumSigner.NoSign = true
-			multiTx, err := c.multicall3.Aggregate(opts, calls)
-			c.ethereumSigner.NoSign = false
+			multiTx, err := c.multicall3.Aggregate(noSignOpts, calls)
 			if err != nil {
 				return err
 			}
@@ -786,7 +916,14 @@ func (iter *CallIter) buildMultiTx(ctx context.Context, c *Chain) (*CallIterBuil
 				return err
 			}
 
+			l1Fee, err := estimateL1Fee(ctx, c, multiTx, 1 == count, logger)
+			if err != nil {
+				return err
+			}
+
 			lastOkGasLimit = txGasLimit
+			lastOkL1Fee = l1Fee
+			lastOkTx = multiTx
 			lastOkCalls = calls
 			return nil
 		})
@@ -798,15 +935,31 @@ func (iter *CallIter) buildMultiTx(ctx context.Context, c *Chain) (*CallIterBuil
 		return nil, err
 	}
 
-	opts.GasLimit = lastOkGasLimit
+	accessList, gasLimit := createAccessList(ctx, c, lastOkTx, lastOkGasLimit, logger)
+	opts.GasLimit = gasLimit
+
+	if blocks, ok := iter.blocksToTimeoutOf(iter.Cursor(), iter.Cursor()+count); ok {
+		logger = &log.RelayLogger{Logger: logger.With(logAttrBlocksToTimeout, blocks)}
+		if remaining, _ := iter.blocksToTimeoutOf(iter.Cursor(), len(iter.msgs)); remaining != blocks {
+			if err := NewGasFeeCalculator(c.client, &c.config).Apply(contextWithBlocksToTimeout(ctx, blocks), opts); err != nil {
+				logger.ErrorContext(ctx, "failed to recalculate gas fees", err)
+				return nil, err
+			}
+		}
+	}
 
 	// add raw tx to log attribute
-	tx, err := c.multicall3.Aggregate(opts, lastOkCalls)
+	signOpts := SignOptions{
+		Logger:   logger,
+		MsgTypes: iter.msgTypeNames[iter.Cursor() : iter.Cursor()+count],
+		Path:     c.pathEnd,
+	}
+	tx, err := c.multicall3.Aggregate(withAccessList(withSignOptions(opts, signer, signOpts), accessList, c.chainID), lastOkCalls)
 	if err != nil {
 		logger.ErrorContext(ctx, "failed to build multicall tx with real send parameters", err)
 		return nil, err
 	}
-	return &CallIterBuildResult{tx, count}, nil
+	return &CallIterBuildResult{tx, count, lastOkL1Fee}, nil
 }
 
 func findItems(
@@ -0,0 +1,184 @@
+package ethereum
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"errors"
+	"math/big"
+	"sync"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	chantypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
+	gethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/hyperledger-labs/yui-relayer/log"
+
+	"github.com/datachainlab/ethereum-ibc-relay-chain/pkg/client"
+)
+
+func TestBuildMessageTxUnsupportedMsg(t *testing.T) {
+	if err := log.InitLogger("DEBUG", "text", "null", false); err != nil {
+		t.Fatal(err)
+	}
+
+	c := &Chain{}
+	_, err := c.BuildMessageTx(&bind.TransactOpts{}, &transfertypes.MsgUpdateParams{}, false)
+	if !errors.Is(err, ErrUnsupportedMsg) {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestTxTransferWithoutTimeoutHeight(t *testing.T) {
+	c := &Chain{}
+	_, err := c.TxTransfer(&bind.TransactOpts{}, &transfertypes.MsgTransfer{
+		SourcePort:       "transfer",
+		SourceChannel:    "channel-0",
+		Token:            sdk.Coin{Denom: "0x0000000000000000000000000000000000000001", Amount: sdkmath.NewInt(100)},
+		TimeoutTimestamp: 1,
+	})
+	if err == nil {
+		t.Fatal("transfer without timeout height must fail")
+	}
+}
+
+// testBytesSigner is a signer.Signer with an in-memory private key
+type testBytesSigner struct {
+	key *ecdsa.PrivateKey
+}
+
+func newTestBytesSigner(t *testing.T) *testBytesSigner {
+	key, err := gethcrypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &testBytesSigner{key: key}
+}
+
+func (s *testBytesSigner) Sign(ctx context.Context, digest []byte) ([]byte, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return gethcrypto.Sign(digest, s.key)
+}
+
+func (s *testBytesSigner) GetPublicKey(ctx context.Context) ([]byte, error) {
+	return gethcrypto.CompressPubkey(&s.key.PublicKey), nil
+}
+
+// testEthService serves the minimum eth namespace methods used to build txs
+type testEthService struct{}
+
+func (testEthService) GetTransactionCount(address common.Address, block string) (hexutil.Uint64, error) {
+	return 1, nil
+}
+
+func (testEthService) GasPrice() (*hexutil.Big, error) {
+	return (*hexutil.Big)(big.NewInt(1_000_000_000)), nil
+}
+
+func (testEthService) EstimateGas(args map[string]interface{}) (hexutil.Uint64, error) {
+	return 100_000, nil
+}
+
+// GetCode returns non-empty code so that bound contracts regard any address as a contract
+func (testEthService) GetCode(address common.Address, block string) (hexutil.Bytes, error) {
+	return hexutil.Bytes{0x00}, nil
+}
+
+// withTestEthService connects the chain to an in-process RPC server serving `service` as the eth namespace
+func withTestEthService(t *testing.T, c *Chain, service interface{}) {
+	withTestRPCServices(t, c, map[string]interface{}{"eth": service})
+}
+
+// withTestRPCServices connects the chain to an in-process RPC server serving `services` as the namespaces of their keys
+func withTestRPCServices(t *testing.T, c *Chain, services map[string]interface{}) {
+	server := rpc.NewServer()
+	for namespace, service := range services {
+		if err := server.RegisterName(namespace, service); err != nil {
+			t.Fatal(err)
+		}
+	}
+	t.Cleanup(server.Stop)
+	ethClient, err := client.NewETHClientWith(ethclient.NewClient(rpc.DialInProc(server)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.client = &ChainClient{ETHClient: ethClient, sentTxs: newSentTxTracker("")}
+	if err := c.bindContracts(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// newTestChain returns a chain connected to an in-process RPC server serving testEthService
+func newTestChain(t *testing.T) *Chain {
+	chainID := big.NewInt(1)
+	ethereumSigner, err := NewEthereumSigner(context.Background(), newTestBytesSigner(t), chainID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := &Chain{
+		config: ChainConfig{
+			TxType:            TxTypeLegacy,
+			GasEstimateRate:   &Fraction{Numerator: 1, Denominator: 1},
+			MaxGasLimit:       10_000_000,
+			IbcAddress:        "0x0000000000000000000000000000000000000001",
+			Multicall3Address: "0x0000000000000000000000000000000000000002",
+		},
+		chainID:        chainID,
+		txMaxSize:      128 * 1024,
+		ethereumSigner: *ethereumSigner,
+	}
+	c.signers = []*EthereumSigner{&c.ethereumSigner}
+	withTestEthService(t, c, testEthService{})
+	return c
+}
+
+// TestCallIterConcurrent builds txs from the same chain in parallel, which is intended to be run with `go test -race`
+func TestCallIterConcurrent(t *testing.T) {
+	if err := log.InitLogger("DEBUG", "text", "null", false); err != nil {
+		t.Fatal(err)
+	}
+	// one chain builds multicall txs and the other builds single txs
+	withMulticall := newTestChain(t)
+	withoutMulticall := newTestChain(t)
+	withoutMulticall.multicall3 = nil
+
+	msgs := make([]sdk.Msg, 3)
+	for i := range msgs {
+		msgs[i] = &chantypes.MsgRecvPacket{Packet: chantypes.Packet{Sequence: uint64(i + 1)}}
+	}
+
+	var wg sync.WaitGroup
+	for _, c := range []*Chain{withMulticall, withoutMulticall, withMulticall, withoutMulticall} {
+		wg.Add(1)
+		go func(c *Chain) {
+			defer wg.Done()
+			iter := NewCallIter(msgs, false)
+			for !iter.End() {
+				built, err := iter.BuildTx(context.Background(), c)
+				if err != nil {
+					t.Error(err)
+					return
+				}
+				sender, err := gethtypes.Sender(c.ethereumSigner.gethSigner, built.tx)
+				if err != nil {
+					t.Error(err)
+					return
+				} else if sender != c.ethereumSigner.Address() {
+					t.Errorf("unexpected sender: %v", sender)
+					return
+				}
+				iter.Next(built.count)
+			}
+		}(c)
+	}
+	wg.Wait()
+}
@@ -34,13 +34,13 @@ func (c *Chain) ProposeUpgrade(
 
 	mockApp, err := iibcchannelupgradablemodule.NewIibcchannelupgradablemodule(
 		appAddr,
-		c.client.Client,
+		c.client,
 	)
 	if err != nil {
 		return nil
 	}
 
-	txOpts, err := c.TxOpts(ctx, true)
+	txOpts, err := c.adminTxOpts(ctx)
 	if err != nil {
 		return err
 	}
@@ -76,13 +76,13 @@ func (c *Chain) AllowTransitionToFlushComplete(
 
 	mockApp, err := iibcchannelupgradablemodule.NewIibcchannelupgradablemodule(
 		appAddr,
-		c.client.Client,
+		c.client,
 	)
 	if err != nil {
 		return nil
 	}
 
-	txOpts, err := c.TxOpts(ctx, true)
+	txOpts, err := c.adminTxOpts(ctx)
 	if err != nil {
 		return err
 	}
@@ -122,13 +122,13 @@ func (c *Chain) ProposeAppVersion(
 
 	mockApp, err := iibccontractupgradablemodule.NewIibccontractupgradablemodule(
 		appAddr,
-		c.client.Client,
+		c.client,
 	)
 	if err != nil {
 		return nil
 	}
 
-	txOpts, err := c.TxOpts(ctx, true)
+	txOpts, err := c.adminTxOpts(ctx)
 	if err != nil {
 		return err
 	}
@@ -160,6 +160,14 @@ func processSendTxResult(ctx context.Context, logger *log.RelayLogger, c *Chain,
 		return err
 	}
 
+	if g := generateOnlyFrom(ctx); g != nil {
+		if err := c.writeUnsignedTx(g, tx); err != nil {
+			logger.ErrorContext(ctx, "failed to write unsigned tx", err)
+			return err
+		}
+		return nil
+	}
+
 	if rawTxData, err := tx.MarshalBinary(); err != nil {
 		logger.ErrorContext(ctx, "failed to encode tx", err)
 	} else {
@@ -168,10 +176,14 @@ func processSendTxResult(ctx context.Context, logger *log.RelayLogger, c *Chain,
 		)}
 	}
 
-	if receipt, err := c.client.WaitForReceiptAndGet(ctx, tx.Hash()); err != nil {
+	receipt, err := c.client.WaitForReceiptAndGet(ctx, tx.Hash())
+	if err != nil {
 		logger.ErrorContext(ctx, "failed to wait for tx receipt", err)
 		return err
-	} else if receipt.Status == gethtypes.ReceiptStatusFailed {
+	}
+	c.recordTxFee(ctx, &receipt.Receipt)
+
+	if receipt.Status == gethtypes.ReceiptStatusFailed {
 		if revertReason, returnData, err := c.getRevertReasonFromReceipt(ctx, receipt); err != nil {
 			logger = &log.RelayLogger{Logger: logger.With(
 				logAttrRawErrorData, hex.EncodeToString(returnData),
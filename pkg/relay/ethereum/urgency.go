@@ -0,0 +1,103 @@
+package ethereum
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"slices"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	chantypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
+)
+
+// noTimeout is the number of blocks to timeout of msgs that never time out
+const noTimeout = math.MaxUint64
+
+type blocksToTimeoutContextKey struct{}
+
+// contextWithBlocksToTimeout returns a context that makes the fees of txs built with it scaled
+// by the urgency tier for `blocks`
+func contextWithBlocksToTimeout(ctx context.Context, blocks uint64) context.Context {
+	return context.WithValue(ctx, blocksToTimeoutContextKey{}, blocks)
+}
+
+// blocksToTimeoutFrom returns the number of blocks to timeout bound to the context
+func blocksToTimeoutFrom(ctx context.Context) (uint64, bool) {
+	blocks, ok := ctx.Value(blocksToTimeoutContextKey{}).(uint64)
+	return blocks, ok
+}
+
+// hasUrgencyTiers returns true if the fees are scaled by the urgency tiers
+func (c ChainConfig) hasUrgencyTiers() bool {
+	return c.TxType == TxTypeDynamic && c.DynamicTxGasConfig != nil && len(c.DynamicTxGasConfig.UrgencyTiers) > 0
+}
+
+// urgencyTier returns the tier with the smallest max_blocks_to_timeout that covers `blocks`,
+// or nil if no tier covers it
+func (c *DynamicTxGasConfig) urgencyTier(blocks uint64) *FeeUrgencyTier {
+	var tier *FeeUrgencyTier
+	for _, t := range c.UrgencyTiers {
+		if blocks <= t.MaxBlocksToTimeout && (tier == nil || t.MaxBlocksToTimeout < tier.MaxBlocksToTimeout) {
+			tier = t
+		}
+	}
+	return tier
+}
+
+// packetBlocksToTimeout returns the number of blocks remaining before the packet times out on this chain,
+// which receives the packet from the counterparty. The revision number of the timeout height is ignored
+// because it is always zero on Ethereum chains.
+func packetBlocksToTimeout(packet chantypes.Packet, latest *gethtypes.Header, blockTime time.Duration) uint64 {
+	blocks := uint64(noTimeout)
+	if h := packet.TimeoutHeight.RevisionHeight; h != 0 {
+		if n := latest.Number.Uint64(); h > n {
+			blocks = h - n
+		} else {
+			blocks = 0
+		}
+	}
+	if ts := packet.TimeoutTimestamp; ts != 0 {
+		now := uint64(time.Unix(int64(latest.Time), 0).UnixNano())
+		if ts > now {
+			blocks = min(blocks, (ts-now)/uint64(blockTime.Nanoseconds()))
+		} else {
+			blocks = 0
+		}
+	}
+	return blocks
+}
+
+// msgsBlocksToTimeout returns the number of blocks remaining before timeout for each msg
+func (c *Chain) msgsBlocksToTimeout(ctx context.Context, msgs []sdk.Msg) ([]uint64, error) {
+	latest, err := c.client.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get latest header: %v", err)
+	}
+	blocks := make([]uint64, len(msgs))
+	for i, msg := range msgs {
+		if msg, ok := msg.(*chantypes.MsgRecvPacket); ok {
+			blocks[i] = packetBlocksToTimeout(msg.Packet, latest, c.AverageBlockTime())
+		} else {
+			blocks[i] = noTimeout
+		}
+	}
+	return blocks, nil
+}
+
+// blocksToTimeoutOf returns the earliest timeout of msgs[from:to] in blocks
+func (iter *CallIter) blocksToTimeoutOf(from, to int) (uint64, bool) {
+	if iter.blocksToTimeout == nil {
+		return 0, false
+	}
+	return slices.Min(iter.blocksToTimeout[from:to]), true
+}
+
+// urgencyContext binds the earliest timeout of msgs[from:to] to the context
+func (iter *CallIter) urgencyContext(ctx context.Context, from, to int) context.Context {
+	if blocks, ok := iter.blocksToTimeoutOf(from, to); ok {
+		return contextWithBlocksToTimeout(ctx, blocks)
+	}
+	return ctx
+}
@@ -0,0 +1,84 @@
+package ethereum
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	chantypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPacketBlocksToTimeout(t *testing.T) {
+	latest := &gethtypes.Header{Number: big.NewInt(100), Time: 1000}
+	blockTime := 2 * time.Second
+	sec := func(s uint64) uint64 { return s * uint64(time.Second) }
+
+	for _, c := range []struct {
+		packet chantypes.Packet
+		blocks uint64
+	}{
+		{chantypes.Packet{}, noTimeout},
+		{chantypes.Packet{TimeoutHeight: clienttypes.NewHeight(0, 110)}, 10},
+		{chantypes.Packet{TimeoutHeight: clienttypes.NewHeight(0, 90)}, 0},
+		{chantypes.Packet{TimeoutTimestamp: sec(1020)}, 10},
+		{chantypes.Packet{TimeoutTimestamp: sec(1000)}, 0},
+		// the earlier one of the two timeouts is used
+		{chantypes.Packet{TimeoutHeight: clienttypes.NewHeight(0, 105), TimeoutTimestamp: sec(1020)}, 5},
+		{chantypes.Packet{TimeoutHeight: clienttypes.NewHeight(0, 200), TimeoutTimestamp: sec(1020)}, 10},
+	} {
+		require.Equal(t, c.blocks, packetBlocksToTimeout(c.packet, latest, blockTime))
+	}
+}
+
+func TestFeeUrgencyTiers(t *testing.T) {
+	config := createConfig()
+	config.DynamicTxGasConfig.UrgencyTiers = []*FeeUrgencyTier{
+		{MaxBlocksToTimeout: 100, PriorityFeeMultiplier: &Fraction{Numerator: 3, Denominator: 2}},
+		{MaxBlocksToTimeout: 10, PriorityFeeMultiplier: &Fraction{Numerator: 3, Denominator: 1}, BaseFeeMultiplier: &Fraction{Numerator: 2, Denominator: 1}},
+	}
+	require.NoError(t, config.DynamicTxGasConfig.ValidateBasic())
+	require.True(t, config.hasUrgencyTiers())
+
+	require.Equal(t, uint64(10), config.DynamicTxGasConfig.urgencyTier(0).MaxBlocksToTimeout)
+	require.Equal(t, uint64(10), config.DynamicTxGasConfig.urgencyTier(10).MaxBlocksToTimeout)
+	require.Equal(t, uint64(100), config.DynamicTxGasConfig.urgencyTier(11).MaxBlocksToTimeout)
+	require.Nil(t, config.DynamicTxGasConfig.urgencyTier(101))
+	require.Nil(t, config.DynamicTxGasConfig.urgencyTier(noTimeout))
+
+	cli := &feeHistoryChainClient{latest: 0, rewards: []int64{10}, baseFees: []int64{100, 100}}
+	calculator := NewGasFeeCalculator(cli, config)
+	for _, c := range []struct {
+		ctx       context.Context
+		gasTipCap int64
+		gasFeeCap int64
+	}{
+		{context.Background(), 10, 10 + 100*2},
+		{contextWithBlocksToTimeout(context.Background(), noTimeout), 10, 10 + 100*2},
+		{contextWithBlocksToTimeout(context.Background(), 50), 15, 15 + 100*2},
+		{contextWithBlocksToTimeout(context.Background(), 5), 30, 30 + 100*2*2},
+	} {
+		txOpts := &bind.TransactOpts{}
+		require.NoError(t, calculator.Apply(c.ctx, txOpts))
+		require.Equal(t, big.NewInt(c.gasTipCap), txOpts.GasTipCap)
+		require.Equal(t, big.NewInt(c.gasFeeCap), txOpts.GasFeeCap)
+	}
+
+	// the scaled fees are capped by the limits
+	config.DynamicTxGasConfig.LimitPriorityFeePerGas = "20wei"
+	config.DynamicTxGasConfig.LimitFeePerGas = "300wei"
+	txOpts := &bind.TransactOpts{}
+	require.NoError(t, calculator.Apply(contextWithBlocksToTimeout(context.Background(), 5), txOpts))
+	require.Equal(t, big.NewInt(20), txOpts.GasTipCap)
+	require.Equal(t, big.NewInt(300), txOpts.GasFeeCap)
+
+	// thresholds must be positive and unique
+	config.DynamicTxGasConfig.UrgencyTiers[0].MaxBlocksToTimeout = 10
+	require.Error(t, config.DynamicTxGasConfig.ValidateBasic())
+	config.DynamicTxGasConfig.UrgencyTiers[0].MaxBlocksToTimeout = 0
+	require.Error(t, config.DynamicTxGasConfig.ValidateBasic())
+}